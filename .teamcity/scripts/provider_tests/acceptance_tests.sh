@@ -43,10 +43,13 @@ TF_ACC=1 go test \
     ./internal/experimental/... \
     ./internal/flex/... \
     ./internal/framework/... \
+    ./internal/function/... \
     ./internal/generate/... \
+    ./internal/iampolicy/... \
     ./internal/json/... \
     ./internal/logging/... \
     ./internal/maps/... \
+    ./internal/metrics/... \
     ./internal/provider/... \
     ./internal/retry/... \
     ./internal/sdkv2/... \
@@ -56,5 +59,6 @@ TF_ACC=1 go test \
     ./internal/tfresource/... \
     ./internal/types/... \
     ./internal/vault/... \
+    ./internal/vcr/... \
     ./internal/verify/... \
     -json -v -count=1 -parallel "%ACCTEST_PARALLELISM%" -timeout=0 -run=TestAcc
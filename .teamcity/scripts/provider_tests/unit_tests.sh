@@ -15,10 +15,13 @@ go test \
     ./internal/experimental/... \
     ./internal/flex/... \
     ./internal/framework/... \
+    ./internal/function/... \
     ./internal/generate/... \
+    ./internal/iampolicy/... \
     ./internal/json/... \
     ./internal/logging/... \
     ./internal/maps/... \
+    ./internal/metrics/... \
     ./internal/provider/... \
     ./internal/retry/... \
     ./internal/sdkv2/... \
@@ -28,5 +31,13 @@ go test \
     ./internal/tfresource/... \
     ./internal/types/... \
     ./internal/vault/... \
+    ./internal/vcr/... \
     ./internal/verify/... \
     -json
+
+# Generator tests, like the generators themselves, build only with the generate tag.
+go test \
+    -tags generate \
+    ./internal/generate/frameworkschema \
+    ./internal/generate/servicepackage \
+    -json
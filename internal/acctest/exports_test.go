@@ -6,4 +6,7 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	NewVCRReplayer   = newVCRReplayer
+	VCRMatcher       = vcrMatcher
+	VCRRedactBody    = vcrRedactBody
 )
@@ -12,14 +12,20 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,15 +36,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
-const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
-)
-
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
@@ -92,21 +95,6 @@ func ProviderMeta(t *testing.T) *conns.AWSClient {
 	return meta
 }
 
-func isVCREnabled() bool {
-	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
-}
-
-func vcrMode() (recorder.Mode, error) {
-	switch v := os.Getenv(envVarVCRMode); v {
-	case "RECORDING":
-		return recorder.ModeRecordOnce, nil
-	case "REPLAYING":
-		return recorder.ModeReplayOnly, nil
-	default:
-		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
-	}
-}
-
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
@@ -145,7 +133,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return meta, nil
 		}
 
-		vcrMode, err := vcrMode()
+		vcrMode, err := vcr.Mode()
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -162,103 +150,51 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 		tlsConfig.MinVersion = tls.VersionTLS12
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		path := filepath.Join(vcr.Path(), vcrFileName(testName))
+		matcher := vcrMatcher(ctx)
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		switch vcrMode {
+		case recorder.ModeReplayOnly:
+			// Replay interactions in recorded order, failing fast on unrecorded interactions.
+			r, err := newVCRReplayer(path, matcher)
 
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
-		}
-
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
 
-			if r.Body == nil {
-				return true
-			}
+			httpClient.Transport = r
+		default:
+			// Create a VCR recorder around a default HTTP client.
+			r, err := recorder.NewWithOptions(&recorder.Options{
+				CassetteName:  path,
+				Mode:          vcrMode,
+				RealTransport: httpClient.Transport,
+			})
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
+			// Remove sensitive HTTP headers and redact secrets from bodies.
+			r.AddHook(func(i *cassette.Interaction) error {
+				delete(i.Request.Headers, "Authorization")
+				delete(i.Request.Headers, "X-Amz-Security-Token")
 
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+				i.Request.Body = vcrRedactBody(i.Request.Headers.Get("Content-Type"), i.Request.Body)
+				i.Response.Body = vcrRedactBody(i.Response.Headers.Get("Content-Type"), i.Response.Body)
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+				return nil
+			}, recorder.AfterCaptureHook)
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+			// Defines how VCR will match requests to responses.
+			r.SetMatcher(matcher)
 
-			return false
-		})
+			httpClient.Transport = r
+		}
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -273,16 +209,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
+		// When replaying there is no need for waiters to wait for resources to change state.
+		meta.SetVCRReplaying(vcrMode == recorder.ModeReplayOnly)
 
 		providerMetas[testName] = meta
 
@@ -290,6 +218,275 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// vcrMatcher returns a function that defines how VCR will match requests to recorded interactions.
+func vcrMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		// Default matcher compares method and URL only.
+		if !cassette.DefaultMatcher(r, i) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		// Recorded request bodies have had any secrets redacted.
+		contentType := r.Header.Get("Content-Type")
+		body := vcrRedactBody(contentType, b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
+
+// vcrReplayer is an http.RoundTripper that replays the interactions recorded in a VCR cassette.
+// Repeated identical requests, e.g. a waiter's Describe* polls, are matched to the recorded interactions in recorded order.
+// Once every matching interaction has been replayed the last of them is repeated, so that additional polls succeed.
+// No time is spent simulating the recorded request latency.
+type vcrReplayer struct {
+	interactions []*cassette.Interaction
+	lock         sync.Mutex
+	matcher      cassette.MatcherFunc
+	replayed     []bool
+}
+
+func newVCRReplayer(name string, matcher cassette.MatcherFunc) (*vcrReplayer, error) {
+	c, err := cassette.Load(name)
+
+	if err != nil {
+		return nil, fmt.Errorf("loading VCR cassette (%s): %w", name, err)
+	}
+
+	return &vcrReplayer{
+		interactions: c.Interactions,
+		matcher:      matcher,
+		replayed:     make([]bool, len(c.Interactions)),
+	}, nil
+}
+
+func (r *vcrReplayer) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := request.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var last *cassette.Interaction
+	for idx, i := range r.interactions {
+		if !r.matcher(request, i.Request) {
+			continue
+		}
+
+		if !r.replayed[idx] {
+			r.replayed[idx] = true
+
+			return i.GetHTTPResponse()
+		}
+
+		last = i
+	}
+
+	if last != nil {
+		return last.GetHTTPResponse()
+	}
+
+	return nil, &vcrInteractionNotFoundError{
+		method: request.Method,
+		url:    request.URL.String(),
+	}
+}
+
+// vcrInteractionNotFoundError is returned when no recorded interaction matches a request.
+// Neither AWS SDK for Go v1 nor v2 retries a request that fails with this error.
+type vcrInteractionNotFoundError struct {
+	method string
+	url    string
+}
+
+func (e *vcrInteractionNotFoundError) Error() string {
+	return fmt.Sprintf("%s: %s %s", cassette.ErrInteractionNotFound, e.method, e.url)
+}
+
+func (e *vcrInteractionNotFoundError) Unwrap() error {
+	return cassette.ErrInteractionNotFound
+}
+
+// Temporary is used by AWS SDK for Go v1 to determine whether a request error is retryable.
+func (e *vcrInteractionNotFoundError) Temporary() bool {
+	return false
+}
+
+// RetryableError is used by AWS SDK for Go v2 to determine whether a request error is retryable.
+func (e *vcrInteractionNotFoundError) RetryableError() bool {
+	return false
+}
+
+const vcrRedacted = "REDACTED"
+
+// vcrRedactedFields are the names of request and response fields whose values are redacted in cassettes.
+var vcrRedactedFields = []string{
+	"AuthToken",
+	"ClientSecret",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SessionToken",
+}
+
+var vcrRedactedXMLElements = tfslices.ApplyToAll(vcrRedactedFields, func(v string) *regexp.Regexp {
+	return regexache.MustCompile(fmt.Sprintf(`<%[1]s>[^<]*</%[1]s>`, v))
+})
+
+// vcrRedactBody redacts the values of secret fields from a request or response body.
+// The body is returned unchanged if it contains no secrets.
+func vcrRedactBody(contentType, body string) string {
+	if body == "" {
+		return body
+	}
+
+	// Ignore any parameters, e.g. "charset".
+	if v, _, err := mime.ParseMediaType(contentType); err == nil {
+		contentType = v
+	}
+
+	switch contentType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v interface{}
+
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return body
+		}
+
+		if !vcrRedactJSON(v) {
+			return body
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return body
+		}
+
+		return string(b)
+
+	case "application/xml", "text/xml":
+		for i, re := range vcrRedactedXMLElements {
+			body = re.ReplaceAllString(body, fmt.Sprintf(`<%[1]s>%[2]s</%[1]s>`, vcrRedactedFields[i], vcrRedacted))
+		}
+
+		return body
+
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+
+		if err != nil {
+			return body
+		}
+
+		var redacted bool
+		for k := range values {
+			// Query protocol parameter names may be prefixed, e.g. "Credentials.SessionToken".
+			if slices.Contains(vcrRedactedFields, k[strings.LastIndex(k, ".")+1:]) {
+				values.Set(k, vcrRedacted)
+				redacted = true
+			}
+		}
+
+		if !redacted {
+			return body
+		}
+
+		return values.Encode()
+	}
+
+	return body
+}
+
+// vcrRedactJSON redacts the values of secret fields in a decoded JSON value in place.
+// Returns whether or not any value was redacted.
+func vcrRedactJSON(v interface{}) bool {
+	var redacted bool
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := e.(string); ok && slices.Contains(vcrRedactedFields, k) {
+				v[k] = vcrRedacted
+				redacted = true
+			} else if vcrRedactJSON(e) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if vcrRedactJSON(e) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -306,7 +503,7 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 		return s, nil
 	}
 
-	vcrMode, err := vcrMode()
+	vcrMode, err := vcr.Mode()
 
 	if err != nil {
 		return nil, err
@@ -320,7 +517,7 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 			source: rand.NewSource(seed),
 		}
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err := readSeedFromFile(vcrSeedFile(vcr.Path(), testName))
 
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
@@ -417,7 +614,7 @@ func closeVCRRecorder(t *testing.T) {
 	if ok {
 		if !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(vcr.Path(), t.Name())); err != nil {
 				t.Error(err)
 			}
 		}
@@ -430,7 +627,7 @@ func closeVCRRecorder(t *testing.T) {
 func ParallelTest(t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if isVCREnabled() {
+	if vcr.IsEnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(t)
 	}
@@ -442,7 +639,7 @@ func ParallelTest(t *testing.T, c resource.TestCase) {
 func Test(t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if isVCREnabled() {
+	if vcr.IsEnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(t)
 	}
@@ -454,7 +651,7 @@ func Test(t *testing.T, c resource.TestCase) {
 func RandInt(t *testing.T) int {
 	t.Helper()
 
-	if !isVCREnabled() {
+	if !vcr.IsEnabled() {
 		return sdkacctest.RandInt()
 	}
 
@@ -4,9 +4,17 @@
 package acctest_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) {
@@ -48,3 +56,140 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRRedactBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType string
+		body        string
+		want        string
+	}{
+		"empty": {
+			contentType: "application/x-amz-json-1.1",
+		},
+		"JSON no secrets": {
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"Name": "test", "NextToken": "abc"}`,
+			want:        `{"Name": "test", "NextToken": "abc"}`,
+		},
+		"JSON nested secrets": {
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"Credentials":{"AccessKeyId":"AKIA","SecretAccessKey":"s3cr3t","SessionToken":"t0k3n"},"Users":[{"Password":"p4ss"}]}`,
+			want:        `{"Credentials":{"AccessKeyId":"AKIA","SecretAccessKey":"REDACTED","SessionToken":"REDACTED"},"Users":[{"Password":"REDACTED"}]}`,
+		},
+		"XML secrets": {
+			contentType: "text/xml; charset=UTF-8",
+			body:        `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>s3cr3t</SecretAccessKey><SessionToken>t0k3n</SessionToken></Credentials>`,
+			want:        `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		"form no secrets": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=DescribeVpcs&VpcId.1=vpc-123&Version=2016-11-15",
+			want:        "Action=DescribeVpcs&VpcId.1=vpc-123&Version=2016-11-15",
+		},
+		"form secrets": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=CreateLoginProfile&Password=p4ss&UserName=test&Version=2010-05-08",
+			want:        "Action=CreateLoginProfile&Password=REDACTED&UserName=test&Version=2010-05-08",
+		},
+		"unknown content type": {
+			contentType: "application/octet-stream",
+			body:        "Password=p4ss",
+			want:        "Password=p4ss",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.VCRRedactBody(testCase.contentType, testCase.body), testCase.want; got != want {
+				t.Errorf("VCRRedactBody = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRReplayer(t *testing.T) {
+	t.Parallel()
+
+	const (
+		body        = `{"DBInstanceIdentifier":"test"}`
+		contentType = "application/x-amz-json-1.1"
+		url         = "https://rds.us-west-2.amazonaws.com/" //lintignore:AWSAT003
+	)
+
+	name := filepath.Join(t.TempDir(), "test")
+	c := cassette.New(name)
+	for _, status := range []string{"creating", "backing-up", "available"} {
+		c.AddInteraction(&cassette.Interaction{
+			Request: cassette.Request{
+				Body:    body,
+				Headers: http.Header{"Content-Type": []string{contentType}},
+				Method:  http.MethodPost,
+				URL:     url,
+			},
+			Response: cassette.Response{
+				Body:    fmt.Sprintf(`{"DBInstanceStatus":%q}`, status),
+				Code:    http.StatusOK,
+				Headers: http.Header{"Content-Type": []string{contentType}},
+			},
+		})
+	}
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	replayer, err := acctest.NewVCRReplayer(name, acctest.VCRMatcher(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roundTrip := func(body string) (string, error) {
+		request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", contentType)
+
+		response, err := replayer.RoundTrip(request)
+		if err != nil {
+			return "", err
+		}
+		defer response.Body.Close()
+
+		b, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(b), nil
+	}
+
+	// Identical requests are replayed in recorded order, repeating the last.
+	for _, status := range []string{"creating", "backing-up", "available", "available"} {
+		got, err := roundTrip(body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if want := fmt.Sprintf(`{"DBInstanceStatus":%q}`, status); got != want {
+			t.Errorf("response = %s, want %s", got, want)
+		}
+	}
+
+	// Unrecorded interactions are not retryable.
+	_, err = roundTrip(`{"DBInstanceIdentifier":"other"}`)
+	if !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, ok := err.(interface{ Temporary() bool }); !ok || v.Temporary() {
+		t.Error("expected AWS SDK for Go v1 non-retryable error")
+	}
+	if v, ok := err.(interface{ RetryableError() bool }); !ok || v.RetryableError() {
+		t.Error("expected AWS SDK for Go v2 non-retryable error")
+	}
+}
@@ -75,7 +75,7 @@ func (m AttributeMap[T]) APIAttributesToResourceData(apiAttributes map[T]string,
 				tfAttributeValue = v
 
 				if attributeInfo.isIAMPolicy {
-					policy, err := verify.PolicyToSet(d.Get(tfAttributeName).(string), tfAttributeValue.(string))
+					policy, err := verify.CanonicalPolicyToSet(d.Get(tfAttributeName).(string), tfAttributeValue.(string))
 
 					if err != nil {
 						return err
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
@@ -20,23 +21,26 @@ import (
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	DNSSuffix         string
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ReverseDNSPrefix  string
-	ServicePackages   map[string]ServicePackage
-	Session           *session_sdkv1.Session
-	TerraformVersion  string
+	AccountID          string
+	DefaultTagsConfig  *tftags.DefaultConfig
+	DNSSuffix          string
+	IgnoreTagsConfig   *tftags.IgnoreConfig
+	Partition          string
+	Region             string
+	RequiredTagsConfig *tftags.RequiredConfig
+	ReverseDNSPrefix   string
+	ServicePackages    map[string]ServicePackage
+	Session            *session_sdkv1.Session
+	TerraformVersion   string
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
+	concurrencyLimiters       map[string]*concurrencyLimiter // From provider configuration.
 	conns                     map[string]any
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -46,6 +50,7 @@ type AWSClient struct {
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	vcrReplaying              bool   // From acceptance test framework.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -60,6 +65,32 @@ func (c *AWSClient) AwsConfig() aws_sdkv2.Config { // nosemgrep:ci.aws-in-func-n
 	return c.awsConfig.Copy()
 }
 
+// DefaultTagsConfigForContext returns the provider's default tags configuration for the resource type in Context.
+func (c *AWSClient) DefaultTagsConfigForContext(ctx context.Context) *tftags.DefaultConfig {
+	var resourceType string
+	if v, ok := FromContext(ctx); ok {
+		resourceType = v.TypeName
+	}
+
+	return c.DefaultTagsConfig.ForResourceType(resourceType)
+}
+
+// TagConstraintsForContext returns the tag constraints of the service package for the resource type in Context, if any.
+func (c *AWSClient) TagConstraintsForContext(ctx context.Context) *tftags.Constraints {
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if v, ok := c.ServicePackages[inContext.ServicePackageName].(interface {
+		TagConstraints() *tftags.Constraints
+	}); ok {
+		return v.TagConstraints().ForResourceType(inContext.TypeName)
+	}
+
+	return nil
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -119,6 +150,21 @@ func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
 }
 
+// SetVCRReplaying sets whether or not AWS API responses are replayed from a VCR cassette.
+// It is called by the acceptance test framework.
+func (c *AWSClient) SetVCRReplaying(replaying bool) {
+	c.vcrReplaying = replaying
+}
+
+// RegisterVCR places the VCR replay mode into Context so that waiters do not wait for resources to change state.
+func (c *AWSClient) RegisterVCR(ctx context.Context) context.Context {
+	if c.vcrReplaying {
+		ctx = vcr.NewReplayingContext(ctx)
+	}
+
+	return ctx
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (c *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
@@ -184,6 +230,17 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.Session,
 	}
+	if l, ok := c.concurrencyLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), l.addSDKv2Middleware)
+		m["aws_sdkv2_config"] = &cfg
+
+		if c.Session != nil {
+			sess := c.Session.Copy()
+			l.addSDKv1Handlers(&sess.Handlers)
+			m["session"] = sess
+		}
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const concurrencyLimiterID = "TerraformAWSProvider:ServiceConcurrencyLimit"
+
+// concurrencyLimiter limits the number of concurrent AWS API requests to a single service.
+// Each request attempt, rather than each API call, holds a slot so that waiting between retries does not.
+type concurrencyLimiter struct {
+	semaphore chan struct{}
+	acquired  sync.Map // AWS SDK for Go v1 requests holding a slot.
+}
+
+func newConcurrencyLimiter(limit int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		semaphore: make(chan struct{}, max(limit, 1)),
+	}
+}
+
+// acquire blocks until a request may be sent or Context is done.
+func (l *concurrencyLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release ends a request started by acquire.
+func (l *concurrencyLimiter) release() {
+	<-l.semaphore
+}
+
+// addSDKv2Middleware adds the concurrency limit to an AWS SDK for Go v2 middleware stack.
+// It is suitable for use as an `aws.Config` APIOptions entry.
+func (l *concurrencyLimiter) addSDKv2Middleware(stack *middleware.Stack) error {
+	m := middleware.FinalizeMiddlewareFunc(
+		concurrencyLimiterID,
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := l.acquire(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer l.release()
+
+			return next.HandleFinalize(ctx, in)
+		},
+	)
+
+	// Limit each attempt. The Retry middleware is in the Finalize step.
+	id := (&retry_sdkv2.Attempt{}).ID()
+	if _, ok := stack.Finalize.Get(id); ok {
+		return stack.Finalize.Insert(m, id, middleware.After)
+	}
+
+	return stack.Finalize.Add(m, middleware.After)
+}
+
+// addSDKv1Handlers adds the concurrency limit to an AWS SDK for Go v1 handler list.
+func (l *concurrencyLimiter) addSDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	handlers.Send.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: concurrencyLimiterID,
+		Fn: func(r *request_sdkv1.Request) {
+			// If Context is done the request is sent without a slot and fails immediately.
+			if err := l.acquire(r.Context()); err == nil {
+				l.acquired.Store(r, struct{}{})
+			}
+		},
+	})
+	handlers.CompleteAttempt.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: concurrencyLimiterID,
+		Fn: func(r *request_sdkv1.Request) {
+			if _, ok := l.acquired.LoadAndDelete(r); ok {
+				l.release()
+			}
+		},
+	})
+}
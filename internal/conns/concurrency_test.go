@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestConcurrencyLimiterSDKv2(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+	l := newConcurrencyLimiter(limit)
+
+	stack := middleware.NewStack("test", func() any { return nil })
+	if err := l.addSDKv2Middleware(stack); err != nil {
+		t.Fatalf("adding middleware: %s", err)
+	}
+
+	var current, peak atomic.Int64
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			v := peak.Load()
+			if n <= v || peak.CompareAndSwap(v, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := handler.Handle(context.Background(), nil); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > limit {
+		t.Errorf("peak concurrency = %d, want <= %d", got, limit)
+	}
+
+	// Waiting for a slot is abandoned when Context is done.
+	l.semaphore <- struct{}{}
+	l.semaphore <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := handler.Handle(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestConcurrencyLimiterSDKv1(t *testing.T) {
+	t.Parallel()
+
+	l := newConcurrencyLimiter(1)
+
+	var handlers request_sdkv1.Handlers
+	l.addSDKv1Handlers(&handlers)
+
+	r1, r2 := &request_sdkv1.Request{}, &request_sdkv1.Request{}
+	handlers.Send.Run(r1)
+
+	sent := make(chan struct{})
+	go func() {
+		handlers.Send.Run(r2)
+		close(sent)
+	}()
+
+	select {
+	case <-sent:
+		t.Fatal("second request sent while first in progress")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	handlers.CompleteAttempt.Run(r1)
+	<-sent
+	handlers.CompleteAttempt.Run(r2)
+
+	// Completing an attempt that does not hold a slot is a no-op.
+	handlers.CompleteAttempt.Run(r2)
+
+	if got := len(l.semaphore); got != 0 {
+		t.Errorf("slots in use = %d, want 0", got)
+	}
+}
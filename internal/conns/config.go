@@ -11,6 +11,7 @@ import (
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -27,6 +29,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIOptions                     []func(*middleware.Stack) error // Additional AWS SDK for Go v2 API client options.
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -44,10 +47,12 @@ type Config struct {
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceConcurrency             map[string]int // Maximum concurrent AWS API requests, keyed by service package name.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -200,14 +205,27 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = names.ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+
+	// Metrics recording hooks must be added before any AWS API clients are created.
+	if metrics.Enabled() {
+		cfg.APIOptions = append(cfg.APIOptions, metrics.AddSDKv2Middleware)
+		metrics.AddSDKv1Handlers(&sess.Handlers)
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
+	client.concurrencyLimiters = make(map[string]*concurrencyLimiter, len(c.ServiceConcurrency))
+	for servicePackageName, limit := range c.ServiceConcurrency {
+		client.concurrencyLimiters[servicePackageName] = newConcurrencyLimiter(limit)
+	}
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
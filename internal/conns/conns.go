@@ -16,9 +16,10 @@ import (
 )
 
 // ServicePackage is the minimal interface exported from each AWS service package.
-// Its methods return the Plugin SDK and Framework resources and data sources implemented in the package.
+// Its methods return the Plugin SDK and Framework resources and data sources, and the Framework provider functions implemented in the package.
 type ServicePackage interface {
 	FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource
+	FrameworkFunctions(context.Context) []*types.ServicePackageFrameworkFunction
 	FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource
 	SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource
 	SDKResources(context.Context) []*types.ServicePackageSDKResource
@@ -38,22 +39,25 @@ type InContext struct {
 	IsDataSource       bool   // Data source?
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
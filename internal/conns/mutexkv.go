@@ -4,7 +4,21 @@
 package conns
 
 import (
+	"context"
+	"fmt"
+	"runtime"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// mutexWaitReportInterval is the interval between log messages while waiting for a lock.
+	mutexWaitReportInterval = 1 * time.Minute
+
+	// mutexHeldHungThreshold is the time after which a lock holder is reported as possibly hung.
+	mutexHeldHungThreshold = 10 * time.Minute
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
@@ -15,35 +29,142 @@ var GlobalMutexKV = newMutexKV()
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a mutex that can be waited on with a Context and that records its current holder.
+type keyMutex struct {
+	ch chan struct{} // Holds a value while locked.
+
+	lock       sync.Mutex
+	holder     string // Location of the caller holding the lock.
+	acquiredAt time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	m.get(key).Lock()
+	// A background Context is never done, so no error is returned.
+	_ = m.lockContext(context.Background(), key)
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is acquired or the Context is done.
+// Any Context deadline, such as a resource operation timeout, bounds the wait.
+// While waiting, the time waited and the current holder are logged periodically and a holder that has held
+// the lock for an unusually long time is reported as possibly hung.
+// Caller is responsible for calling Unlock for the same key if, and only if, no error is returned.
+func (m *mutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	m.get(key).Unlock()
+	mutex := m.get(key)
+
+	mutex.lock.Lock()
+	mutex.holder = ""
+	mutex.acquiredAt = time.Time{}
+	mutex.lock.Unlock()
+
+	select {
+	case <-mutex.ch:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked mutex (%s)", key))
+	}
+}
+
+func (m *mutexKV) lockContext(ctx context.Context, key string) error {
+	mutex := m.get(key)
+	holder := callerLocation(3)
+	start := time.Now()
+
+	// Fast path.
+	select {
+	case mutex.ch <- struct{}{}:
+		mutex.setHolder(holder)
+		return nil
+	default:
+	}
+
+	ctx = tflog.SetField(ctx, "mutex_key", key)
+	tflog.Debug(ctx, "Waiting for lock")
+
+	ticker := time.NewTicker(mutexWaitReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case mutex.ch <- struct{}{}:
+			mutex.setHolder(holder)
+			tflog.Debug(ctx, "Acquired lock", map[string]any{
+				"wait_time": time.Since(start).String(),
+			})
+			return nil
+		case <-ctx.Done():
+			currentHolder, heldFor := mutex.currentHolder()
+			return fmt.Errorf("waiting %s for lock (%s) held for %s by %s: %w", time.Since(start).Truncate(time.Second), key, heldFor.Truncate(time.Second), currentHolder, ctx.Err())
+		case <-ticker.C:
+			currentHolder, heldFor := mutex.currentHolder()
+			fields := map[string]any{
+				"wait_time": time.Since(start).Truncate(time.Second).String(),
+				"holder":    currentHolder,
+				"held_for":  heldFor.Truncate(time.Second).String(),
+			}
+			if heldFor >= mutexHeldHungThreshold {
+				tflog.Warn(ctx, "Lock holder has not released the lock and may be hung", fields)
+			} else {
+				tflog.Info(ctx, "Still waiting for lock", fields)
+			}
+		}
+	}
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyMutex{
+			ch: make(chan struct{}, 1),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+func (m *keyMutex) setHolder(holder string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.holder = holder
+	m.acquiredAt = time.Now()
+}
+
+// currentHolder returns the location of the current lock holder and how long it has held the lock.
+func (m *keyMutex) currentHolder() (string, time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.acquiredAt.IsZero() {
+		return "unknown", 0
+	}
+
+	return m.holder, time.Since(m.acquiredAt)
+}
+
+// callerLocation returns the file and line of the caller the specified number of frames up the stack.
+func callerLocation(skip int) string {
+	if _, file, line, ok := runtime.Caller(skip); ok {
+		return fmt.Sprintf("%s:%d", file, line)
+	}
+
+	return "unknown"
+}
+
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
@@ -4,6 +4,9 @@
 package conns
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -74,3 +77,81 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContext(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+	ctx := context.Background()
+
+	if err := mkv.LockContext(ctx, "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- mkv.LockContext(ctx, "foo")
+	}()
+
+	select {
+	case <-errCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	mkv.Unlock("foo")
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock blocked after unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVLockContextTimeout(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The error identifies the lock holder.
+	if !strings.Contains(err.Error(), "mutexkv_test.go") {
+		t.Errorf("error does not identify holder: %s", err)
+	}
+
+	// The lock is still held by the original holder.
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVUnlockUnlocked(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Unlock of unlocked mutex did not panic. This shouldn't happen.")
+		}
+	}()
+
+	mkv.Unlock("foo")
+}
@@ -84,6 +84,34 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// Set to any value to list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The file to which the dry-run inventory is written.
+	// Defaults to sweeper-inventory.json in the working directory.
+	SweepInventoryFile = "TF_AWS_SWEEP_INVENTORY_FILE"
+
+	// Comma-separated tag key=value pairs that a resource must have to be swept.
+	// A key without a value matches any value.
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// Comma-separated name prefixes, one of which a resource's name must start with to be swept
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// The minimum age, as a Go duration (e.g. 24h), of a resource to be swept.
+	// Resources whose creation time is unknown are not swept.
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// The maximum number of resources deleted concurrently by each sweeper.
+	// Defaults to 20.
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// Comma-separated service=limit pairs overriding the concurrency for resources of a service, e.g. ec2=5,iam=2
+	SweepServiceConcurrency = "TF_AWS_SWEEP_SERVICE_CONCURRENCY"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -75,7 +75,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := r.Meta().DefaultTagsConfigForContext(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,7 +32,15 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 		optFn(expander)
 	}
 
-	diags.Append(autoFlexConvert(ctx, tfObject, apiObject, expander)...)
+	if v, ok := tfObject.(Expander); ok {
+		_, valTo, d := autoFlexValues(ctx, tfObject, apiObject)
+		diags.Append(d...)
+		if !diags.HasError() {
+			diags.Append(expander.fromExpander(ctx, v, valTo)...)
+		}
+	} else {
+		diags.Append(autoFlexConvert(ctx, tfObject, apiObject, expander)...)
+	}
 	if diags.HasError() {
 		diags.AddError("AutoFlEx", fmt.Sprintf("Expand[%T, %T]", tfObject, apiObject))
 		return diags
@@ -40,7 +49,10 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
-type autoExpander struct{}
+type autoExpander struct {
+	smithyDocumentFuncs map[reflect.Type]func(string) (any, error)
+	unionMembers        []reflect.Type
+}
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
 func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
@@ -58,6 +70,11 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 	}
 
 	switch vFrom := vFrom.(type) {
+	// Values that expand themselves.
+	case Expander:
+		diags.Append(expander.fromExpander(ctx, vFrom, vTo)...)
+		return diags
+
 	// Primitive types.
 	case basetypes.BoolValuable:
 		diags.Append(expander.bool(ctx, vFrom, vTo)...)
@@ -101,6 +118,57 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 	return diags
 }
 
+// convertField converts a single Plugin Framework struct field value to its AWS API equivalent.
+func (expander autoExpander) convertField(ctx context.Context, opts fieldOpts, valFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if opts.omitempty || opts.legacy {
+		if v, ok := valFrom.Interface().(attr.Value); ok && isZeroValue(ctx, v) {
+			return diags
+		}
+	}
+
+	diags.Append(expander.convert(ctx, valFrom, vTo)...)
+	return diags
+}
+
+// fromExpander sets `vTo` to the value returned by the Expander.
+func (expander autoExpander) fromExpander(ctx context.Context, from Expander, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expanded, d := from.Expand(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if expanded == nil {
+		return diags
+	}
+
+	vExpanded := reflect.ValueOf(expanded)
+	switch tExpanded, tTo := vExpanded.Type(), vTo.Type(); {
+	case tExpanded.AssignableTo(tTo):
+		vTo.Set(vExpanded)
+		return diags
+
+	case tExpanded.Kind() == reflect.Ptr && tExpanded.Elem().AssignableTo(tTo):
+		if !vExpanded.IsNil() {
+			vTo.Set(vExpanded.Elem())
+		}
+		return diags
+
+	case tTo.Kind() == reflect.Ptr && tExpanded.AssignableTo(tTo.Elem()):
+		to := reflect.New(tTo.Elem())
+		to.Elem().Set(vExpanded)
+		vTo.Set(to)
+		return diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("Expand[%T] returned %T, want %s", from, expanded, vTo.Type()))
+	return diags
+}
+
 // bool copies a Plugin Framework Bool(ish) value to a compatible AWS API value.
 func (expander autoExpander) bool(ctx context.Context, vFrom basetypes.BoolValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -255,6 +323,21 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			return diags
 		}
 
+	case reflect.Interface:
+		//
+		// types.String -> Smithy document.
+		//
+		if f, ok := expander.smithyDocumentFuncs[tTo]; ok {
+			doc, err := f(v.ValueString())
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("Smithy document (%s): %s", tTo, err))
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(doc))
+			return diags
+		}
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.String:
@@ -303,6 +386,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 			return diags
 		}
 
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.Struct:
@@ -558,6 +650,13 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 		diags.Append(expander.nestedObjectToStruct(ctx, vFrom, tTo, vTo)...)
 		return diags
 
+	case reflect.Interface:
+		//
+		// types.List(OfObject) -> union.
+		//
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+		return diags
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.Struct:
@@ -603,6 +702,13 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 				diags.Append(expander.nestedObjectToSlice(ctx, vFrom, tTo, tElem, vTo)...)
 				return diags
 			}
+
+		case reflect.Interface:
+			//
+			// types.List(OfObject) -> []union.
+			//
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, vTo)...)
+			return diags
 		}
 	}
 
@@ -621,6 +727,11 @@ func (expander autoExpander) nestedObjectToStruct(ctx context.Context, vFrom fwt
 		return diags
 	}
 
+	if from, ok := from.(Expander); ok {
+		diags.Append(expander.fromExpander(ctx, from, vTo)...)
+		return diags
+	}
+
 	// Create a new target structure and walk its fields.
 	to := reflect.New(tStruct)
 	diags.Append(autoFlexConvertStruct(ctx, from, to.Interface(), expander)...)
@@ -654,6 +765,15 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	n := f.Len()
 	t := reflect.MakeSlice(tSlice, n, n)
 	for i := 0; i < n; i++ {
+		if from, ok := f.Index(i).Interface().(Expander); ok {
+			diags.Append(expander.fromExpander(ctx, from, t.Index(i))...)
+			if diags.HasError() {
+				return diags
+			}
+
+			continue
+		}
+
 		// Create a new target structure and walk its fields.
 		target := reflect.New(tElem)
 		diags.Append(autoFlexConvertStruct(ctx, f.Index(i).Interface(), target.Interface(), expander)...)
@@ -674,6 +794,132 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(expander.union(ctx, from, vTo)...)
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []union value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, n, n)
+	for i := 0; i < n; i++ {
+		diags.Append(expander.union(ctx, f.Index(i).Interface(), t.Index(i))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// union sets the AWS API union value `vTo` to the member corresponding to the single non-null field of
+// the Plugin Framework object pointer `from`.
+// The union value is not set if all the object's fields are null.
+func (expander autoExpander) union(ctx context.Context, from any, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if from, ok := from.(Expander); ok {
+		diags.Append(expander.fromExpander(ctx, from, vTo)...)
+		return diags
+	}
+
+	tUnion := vTo.Type()
+	valFrom := reflect.ValueOf(from).Elem()
+	var fieldName string
+	var fieldVal reflect.Value
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		opts := autoFlexFieldOpts(field)
+		if opts.ignore {
+			continue
+		}
+
+		if v, ok := valFrom.Field(i).Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if fieldVal.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): more than one member set (%s, %s)", tUnion, fieldName, field.Name))
+			return diags
+		}
+
+		fieldName, fieldVal = field.Name, valFrom.Field(i)
+		if opts.name != "" {
+			fieldName = opts.name
+		}
+	}
+
+	if !fieldVal.IsValid() {
+		return diags
+	}
+
+	tMember := expander.unionMember(tUnion, fieldName)
+	if tMember == nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): no member registered for %s", tUnion, fieldName))
+		return diags
+	}
+
+	// Create a new union member and expand the field into its value.
+	to := reflect.New(tMember)
+	toFieldVal := to.Elem().FieldByName("Value")
+	if !toFieldVal.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member (%s): no Value field", tMember))
+		return diags
+	}
+
+	diags.Append(expander.convert(ctx, fieldVal, toFieldVal)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(to)
+
+	return diags
+}
+
+// unionMember returns the registered member type of union `tUnion` corresponding to the specified field name.
+func (expander autoExpander) unionMember(tUnion reflect.Type, fieldName string) reflect.Type {
+	for _, tMember := range expander.unionMembers {
+		if !reflect.PointerTo(tMember).Implements(tUnion) {
+			continue
+		}
+
+		if v, ok := strings.CutPrefix(tMember.Name(), tUnion.Name()+"Member"); ok && strings.EqualFold(v, fieldName) {
+			return tMember
+		}
+	}
+
+	return nil
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -719,6 +965,52 @@ func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwt
 	return diags
 }
 
+// isZeroValue returns whether a Plugin Framework value is null, unknown or the zero value of its type, e.g. "".
+func isZeroValue(ctx context.Context, v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return true
+	}
+
+	switch v := v.(type) {
+	case basetypes.BoolValuable:
+		if v, d := v.ToBoolValue(ctx); !d.HasError() {
+			return !v.ValueBool()
+		}
+
+	case basetypes.Float64Valuable:
+		if v, d := v.ToFloat64Value(ctx); !d.HasError() {
+			return v.ValueFloat64() == 0
+		}
+
+	case basetypes.Int64Valuable:
+		if v, d := v.ToInt64Value(ctx); !d.HasError() {
+			return v.ValueInt64() == 0
+		}
+
+	case basetypes.StringValuable:
+		if v, d := v.ToStringValue(ctx); !d.HasError() {
+			return v.ValueString() == ""
+		}
+
+	case basetypes.ListValuable:
+		if v, d := v.ToListValue(ctx); !d.HasError() {
+			return len(v.Elements()) == 0
+		}
+
+	case basetypes.MapValuable:
+		if v, d := v.ToMapValue(ctx); !d.HasError() {
+			return len(v.Elements()) == 0
+		}
+
+	case basetypes.SetValuable:
+		if v, d := v.ToSetValue(ctx); !d.HasError() {
+			return len(v.Elements()) == 0
+		}
+	}
+
+	return false
+}
+
 // blockKeyMap takes a struct and extracts the value of the `key`
 func blockKeyMap(from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -706,11 +706,191 @@ func TestExpandComplexSingleNestedBlock(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unionMembers := WithUnionMembers(&TestFlexUnionMemberString{}, &TestFlexUnionMemberStruct{})
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "string member",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringValue("a"), Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)})},
+			Target:     &TestFlexUnionAWS02{},
+			Options:    []AutoFlexOptionsFunc{unionMembers},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionMemberString{Value: "a"}},
+		},
+		{
+			TestName:   "struct member",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringNull(), Struct: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")})})},
+			Target:     &TestFlexUnionAWS02{},
+			Options:    []AutoFlexOptionsFunc{unionMembers},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionMemberStruct{Value: TestFlexAWS01{Field1: "a"}}},
+		},
+		{
+			TestName:   "no member set",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringNull(), Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)})},
+			Target:     &TestFlexUnionAWS02{},
+			Options:    []AutoFlexOptionsFunc{unionMembers},
+			WantTarget: &TestFlexUnionAWS02{},
+		},
+		{
+			TestName:   "null block",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+			Target:     &TestFlexUnionAWS02{},
+			Options:    []AutoFlexOptionsFunc{unionMembers},
+			WantTarget: &TestFlexUnionAWS02{},
+		},
+		{
+			TestName: "more than one member set",
+			Source:   &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringValue("a"), Struct: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")})})},
+			Target:   &TestFlexUnionAWS02{},
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			WantErr:  true,
+		},
+		{
+			TestName: "member not registered",
+			Source:   &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringValue("a"), Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)})},
+			Target:   &TestFlexUnionAWS02{},
+			WantErr:  true,
+		},
+		{
+			TestName: "list of unions",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSlice(ctx, []*TestFlexUnionTF01{
+				{String: types.StringValue("a"), Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)},
+				{String: types.StringNull(), Struct: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("b")})},
+			})},
+			Target:  &TestFlexUnionAWS03{},
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			WantTarget: &TestFlexUnionAWS03{Field1: []TestFlexUnion{
+				&TestFlexUnionMemberString{Value: "a"},
+				&TestFlexUnionMemberStruct{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	smithyDocumentFunc := WithSmithyDocumentFunc(newTestFlexDocument)
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "JSON string",
+			Source:     &TestFlexTF01{Field1: types.StringValue(`{"a":[1,"b"]}`)},
+			Target:     &TestFlexDocumentAWS01{},
+			Options:    []AutoFlexOptionsFunc{smithyDocumentFunc},
+			WantTarget: &TestFlexDocumentAWS01{Field1: newTestFlexDocument(map[string]any{"a": []any{float64(1), "b"}})},
+		},
+		{
+			TestName:   "null string",
+			Source:     &TestFlexTF01{Field1: types.StringNull()},
+			Target:     &TestFlexDocumentAWS01{},
+			Options:    []AutoFlexOptionsFunc{smithyDocumentFunc},
+			WantTarget: &TestFlexDocumentAWS01{},
+		},
+		{
+			TestName: "invalid JSON",
+			Source:   &TestFlexTF01{Field1: types.StringValue(`{"a":`)},
+			Target:   &TestFlexDocumentAWS01{},
+			Options:  []AutoFlexOptionsFunc{smithyDocumentFunc},
+			WantErr:  true,
+		},
+		{
+			TestName:   "no Smithy document function",
+			Source:     &TestFlexTF01{Field1: types.StringValue(`{"a":1}`)},
+			Target:     &TestFlexDocumentAWS01{},
+			WantTarget: &TestFlexDocumentAWS01{},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandFieldTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "values",
+			Source: &TestFlexTagsTF01{
+				Name:     types.StringValue("a"),
+				Ignored:  types.StringValue("b"),
+				Optional: types.StringValue("c"),
+				Legacy:   types.StringValue("d"),
+				Count:    types.Int64Value(1),
+			},
+			Target: &TestFlexTagsAWS01{},
+			WantTarget: &TestFlexTagsAWS01{
+				FieldName: aws.String("a"),
+				Optional:  aws.String("c"),
+				Legacy:    aws.String("d"),
+				Count:     aws.Int64(1),
+			},
+		},
+		{
+			TestName: "zero values",
+			Source: &TestFlexTagsTF01{
+				Name:     types.StringValue(""),
+				Ignored:  types.StringValue(""),
+				Optional: types.StringValue(""),
+				Legacy:   types.StringValue(""),
+				Count:    types.Int64Value(0),
+			},
+			Target: &TestFlexTagsAWS01{},
+			WantTarget: &TestFlexTagsAWS01{
+				FieldName: aws.String(""),
+			},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandExpander(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "top level",
+			Source:     &TestFlexExpanderTF01{Field1: types.StringValue("a")},
+			Target:     &TestFlexAWS01{},
+			WantTarget: &TestFlexAWS01{Field1: "A"},
+		},
+		{
+			TestName:   "nested *struct",
+			Source:     &TestFlexExpanderTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexExpanderTF01{Field1: types.StringValue("a")})},
+			Target:     &TestFlexAWS06{},
+			WantTarget: &TestFlexAWS06{Field1: &TestFlexAWS01{Field1: "A"}},
+		},
+		{
+			TestName: "nested []struct",
+			Source: &TestFlexExpanderTF02{Field1: fwtypes.NewListNestedObjectValueOfSlice(ctx, []*TestFlexExpanderTF01{
+				{Field1: types.StringValue("a")},
+				{Field1: types.StringValue("b")},
+			})},
+			Target:     &TestFlexAWS08{},
+			WantTarget: &TestFlexAWS08{Field1: []TestFlexAWS01{{Field1: "A"}, {Field1: "B"}}},
+		},
+		{
+			TestName: "incompatible result",
+			Source:   &TestFlexExpanderTF03{Field1: types.StringValue("a")},
+			Target:   &TestFlexAWS01{},
+			WantErr:  true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	TestName   string
 	Source     any
 	Target     any
+	Options    []AutoFlexOptionsFunc
 	WantErr    bool
 	WantTarget any
 }
@@ -730,7 +910,7 @@ func runAutoExpandTestCases(ctx context.Context, t *testing.T, testCases autoFle
 				testCtx = testCase.Context
 			}
 
-			err := Expand(testCtx, testCase.Source, testCase.Target)
+			err := Expand(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
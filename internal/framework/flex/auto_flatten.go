@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
+	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 // Flatten = AWS --> TF
@@ -33,7 +37,11 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 		optFn(flattener)
 	}
 
-	diags.Append(autoFlexConvert(ctx, apiObject, tfObject, flattener)...)
+	if v, ok := tfObject.(Flattener); ok {
+		diags.Append(v.Flatten(ctx, apiObject)...)
+	} else {
+		diags.Append(autoFlexConvert(ctx, apiObject, tfObject, flattener)...)
+	}
 	if diags.HasError() {
 		diags.AddError("AutoFlEx", fmt.Sprintf("Flatten[%T, %T]", apiObject, tfObject))
 		return diags
@@ -54,6 +62,14 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 	}
 
+	// Values that flatten themselves.
+	if vTo.CanAddr() {
+		if v, ok := vTo.Addr().Interface().(Flattener); ok {
+			diags.Append(v.Flatten(ctx, vFrom.Interface())...)
+			return diags
+		}
+	}
+
 	tTo := valTo.Type(ctx)
 	switch k := vFrom.Kind(); k {
 	case reflect.Bool:
@@ -87,6 +103,10 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 	case reflect.Struct:
 		diags.Append(flattener.struct_(ctx, vFrom, false, tTo, vTo)...)
 		return diags
+
+	case reflect.Interface:
+		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -97,6 +117,54 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 	return diags
 }
 
+// convertField converts a single AWS API struct field value to its Plugin Framework equivalent.
+func (flattener autoFlattener) convertField(ctx context.Context, opts fieldOpts, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if opts.omitempty && isZero(vFrom) {
+		valTo, ok := vTo.Interface().(attr.Value)
+		if !ok {
+			diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+			return diags
+		}
+
+		null, err := nullValue(ctx, valTo.Type(ctx))
+		if err != nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("null value (%s): %s", vTo.Type(), err))
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(null))
+		return diags
+	}
+
+	if opts.legacy {
+		// Flatten nil values to zero values.
+		switch vFrom.Kind() {
+		case reflect.Ptr:
+			switch tElem := vFrom.Type().Elem(); tElem.Kind() {
+			case reflect.Bool, reflect.Float32, reflect.Float64, reflect.Int32, reflect.Int64, reflect.String:
+				if vFrom.IsNil() {
+					vFrom = reflect.New(tElem)
+				}
+			}
+
+		case reflect.Slice:
+			if vFrom.IsNil() {
+				vFrom = reflect.MakeSlice(vFrom.Type(), 0, 0)
+			}
+
+		case reflect.Map:
+			if vFrom.IsNil() {
+				vFrom = reflect.MakeMap(vFrom.Type())
+			}
+		}
+	}
+
+	diags.Append(flattener.convert(ctx, vFrom, vTo)...)
+	return diags
+}
+
 // bool copies an AWS API bool value to a compatible Plugin Framework value.
 func (flattener autoFlattener) bool(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -320,6 +388,74 @@ func (flattener autoFlattener) struct_(ctx context.Context, vFrom reflect.Value,
 	return diags
 }
 
+// interface_ copies an AWS API interface value to a compatible Plugin Framework value.
+func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tTo := tTo.(type) {
+	case basetypes.StringTypable:
+		if doc, ok := vFrom.Interface().(smithydocument.Unmarshaler); ok || vFrom.IsNil() {
+			//
+			// Smithy document -> types.String.
+			//
+			stringValue := types.StringNull()
+			if !vFrom.IsNil() {
+				s, err := tfjson.SmithyDocumentToString(doc)
+				if err != nil {
+					diags.AddError("AutoFlEx", fmt.Sprintf("Smithy document (%s): %s", vFrom.Type(), err))
+					return diags
+				}
+				stringValue = types.StringValue(s)
+			}
+			v, d := tTo.ValueFromString(ctx, stringValue)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(v))
+			return diags
+		}
+
+	case fwtypes.NestedObjectType:
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		if vFrom.IsNil() {
+			val, d := tTo.NullValue(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
+		to, d := flattener.union(ctx, vFrom, tTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
 // slice copies an AWS API slice value to a compatible Plugin Framework value.
 func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -489,6 +625,15 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 			diags.Append(flattener.sliceOfStructNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
 			return diags
 		}
+
+	case reflect.Interface:
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			//
+			// []union -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -712,7 +857,11 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, vFrom r
 		return diags
 	}
 
-	diags.Append(autoFlexConvertStruct(ctx, vFrom.Interface(), to, flattener)...)
+	if v, ok := to.(Flattener); ok {
+		diags.Append(v.Flatten(ctx, vFrom.Interface())...)
+	} else {
+		diags.Append(autoFlexConvertStruct(ctx, vFrom.Interface(), to, flattener)...)
+	}
 	if diags.HasError() {
 		return diags
 	}
@@ -759,7 +908,11 @@ func (flattener autoFlattener) sliceOfStructNestedObjectCollection(ctx context.C
 			return diags
 		}
 
-		diags.Append(autoFlexConvertStruct(ctx, vFrom.Index(i).Interface(), target, flattener)...)
+		if v, ok := target.(Flattener); ok {
+			diags.Append(v.Flatten(ctx, vFrom.Index(i).Interface())...)
+		} else {
+			diags.Append(autoFlexConvertStruct(ctx, vFrom.Index(i).Interface(), target, flattener)...)
+		}
 		if diags.HasError() {
 			return diags
 		}
@@ -778,6 +931,150 @@ func (flattener autoFlattener) sliceOfStructNestedObjectCollection(ctx context.C
 	return diags
 }
 
+// sliceOfUnionNestedObjectCollection copies an AWS API []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		if vFrom.Index(i).IsNil() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): nil element", vFrom.Type().Elem()))
+			return diags
+		}
+
+		target, d := flattener.union(ctx, vFrom.Index(i), tTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// union returns a new Plugin Framework object pointer with the field corresponding to the member of the (non-nil) AWS API union value `vFrom` set.
+// All other fields are null.
+func (flattener autoFlattener) union(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vMember := vFrom.Elem()
+	if vMember.Kind() == reflect.Ptr {
+		vMember = vMember.Elem()
+	}
+
+	// e.g. ConditionMemberEquals is the Equals member of the Condition union.
+	memberName, ok := strings.CutPrefix(vMember.Type().Name(), vFrom.Type().Name()+"Member")
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): unsupported member %s", vFrom.Type(), vMember.Type()))
+		return nil, diags
+	}
+
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if v, ok := to.(Flattener); ok {
+		diags.Append(v.Flatten(ctx, vFrom.Interface())...)
+		return to, diags
+	}
+
+	valTo := reflect.ValueOf(to).Elem()
+	var toFieldVal reflect.Value
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		fieldName := field.Name
+		if opts := autoFlexFieldOpts(field); opts.ignore {
+			continue
+		} else if opts.name != "" {
+			fieldName = opts.name
+		}
+
+		if strings.EqualFold(fieldName, memberName) {
+			toFieldVal = valTo.Field(i)
+			continue
+		}
+
+		v, ok := valTo.Field(i).Interface().(attr.Value)
+		if !ok {
+			continue
+		}
+
+		null, err := nullValue(ctx, v.Type(ctx))
+		if err != nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("null value (%s): %s", field.Name, err))
+			return nil, diags
+		}
+
+		valTo.Field(i).Set(reflect.ValueOf(null))
+	}
+
+	if !toFieldVal.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): no field for member %s", vFrom.Type(), memberName))
+		return nil, diags
+	}
+
+	diags.Append(flattener.convert(ctx, vMember.FieldByName("Value"), toFieldVal)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return to, diags
+}
+
+// isZero returns whether an AWS API value is nil or the zero value of its type.
+// Empty slices and maps are considered zero values.
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil() || isZero(v.Elem())
+
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
+// nullValue returns the null value of a Plugin Framework type.
+func nullValue(ctx context.Context, typ attr.Type) (attr.Value, error) {
+	return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+}
+
 // blockKeyMapSet takes a struct and assigns the value of the `key`
 func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
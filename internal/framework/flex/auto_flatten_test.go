@@ -816,6 +816,150 @@ func TestFlattenComplexSingleNestedBlock(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "string member",
+			Source:     &TestFlexUnionAWS02{Field1: &TestFlexUnionMemberString{Value: "a"}},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringValue("a"), Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)})},
+		},
+		{
+			TestName:   "struct member",
+			Source:     &TestFlexUnionAWS02{Field1: &TestFlexUnionMemberStruct{Value: TestFlexAWS01{Field1: "a"}}},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{String: types.StringNull(), Struct: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")})})},
+		},
+		{
+			TestName:   "nil union",
+			Source:     &TestFlexUnionAWS02{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+		{
+			TestName: "unknown member",
+			Source:   &TestFlexUnionAWS02{Field1: &TestFlexUnknownUnionMember{Tag: "new"}},
+			Target:   &TestFlexUnionTF02{},
+			WantErr:  true,
+		},
+		{
+			TestName: "slice of unions",
+			Source: &TestFlexUnionAWS03{Field1: []TestFlexUnion{
+				&TestFlexUnionMemberString{Value: "a"},
+				&TestFlexUnionMemberStruct{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+			Target: &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSlice(ctx, []*TestFlexUnionTF01{
+				{String: types.StringValue("a"), Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)},
+				{String: types.StringNull(), Struct: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("b")})},
+			})},
+		},
+		{
+			TestName:   "nil slice of unions",
+			Source:     &TestFlexUnionAWS03{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "Smithy document",
+			Source:     &TestFlexDocumentAWS01{Field1: newTestFlexDocument(map[string]any{"a": []any{1, "b"}})},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{Field1: types.StringValue(`{"a":[1,"b"]}`)},
+		},
+		{
+			TestName:   "nil Smithy document",
+			Source:     &TestFlexDocumentAWS01{},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{Field1: types.StringNull()},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenFieldTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "values",
+			Source: &TestFlexTagsAWS01{
+				FieldName: aws.String("a"),
+				Name:      aws.String("x"),
+				Ignored:   aws.String("b"),
+				Optional:  aws.String("c"),
+				Legacy:    aws.String("d"),
+				Count:     aws.Int64(1),
+			},
+			Target: &TestFlexTagsTF01{},
+			WantTarget: &TestFlexTagsTF01{
+				Name:     types.StringValue("a"),
+				Ignored:  types.StringNull(),
+				Optional: types.StringValue("c"),
+				Legacy:   types.StringValue("d"),
+				Count:    types.Int64Value(1),
+			},
+		},
+		{
+			TestName: "zero values",
+			Source: &TestFlexTagsAWS01{
+				Optional: aws.String(""),
+			},
+			Target: &TestFlexTagsTF01{},
+			WantTarget: &TestFlexTagsTF01{
+				Name:     types.StringNull(),
+				Ignored:  types.StringNull(),
+				Optional: types.StringNull(),
+				Legacy:   types.StringValue(""),
+				Count:    types.Int64Value(0),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenFlattener(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "top level",
+			Source:     &TestFlexAWS01{Field1: "A"},
+			Target:     &TestFlexExpanderTF01{},
+			WantTarget: &TestFlexExpanderTF01{Field1: types.StringValue("a")},
+		},
+		{
+			TestName:   "nested *struct",
+			Source:     &TestFlexAWS06{Field1: &TestFlexAWS01{Field1: "A"}},
+			Target:     &TestFlexExpanderTF02{},
+			WantTarget: &TestFlexExpanderTF02{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexExpanderTF01{Field1: types.StringValue("a")})},
+		},
+		{
+			TestName: "nested []struct",
+			Source:   &TestFlexAWS08{Field1: []TestFlexAWS01{{Field1: "A"}, {Field1: "B"}}},
+			Target:   &TestFlexExpanderTF02{},
+			WantTarget: &TestFlexExpanderTF02{Field1: fwtypes.NewListNestedObjectValueOfSlice(ctx, []*TestFlexExpanderTF01{
+				{Field1: types.StringValue("a")},
+				{Field1: types.StringValue("b")},
+			})},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -829,7 +973,7 @@ func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFl
 				testCtx = testCase.Context
 			}
 
-			err := Flatten(testCtx, testCase.Source, testCase.Target)
+			err := Flatten(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
+	smithydocument "github.com/aws/smithy-go/document"
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 type ResourcePrefixCtxKey string
@@ -27,11 +30,60 @@ const (
 // autoFlexer is the interface implemented by an auto-flattener or expander.
 type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	// convertField converts a struct field whose Plugin Framework model field has the specified `autoflex` options.
+	convertField(context.Context, fieldOpts, reflect.Value, reflect.Value) diag.Diagnostics
+}
+
+// Expander is implemented by Plugin Framework model types (and attr.Values) that expand themselves.
+// Expand returns the AWS API value, which must be assignable to the target (or a pointer to a value that is).
+type Expander interface {
+	Expand(ctx context.Context) (any, diag.Diagnostics)
+}
+
+// Flattener is implemented by Plugin Framework model types (and attr.Values) that flatten themselves.
+// Flatten is called on a pointer to a new, empty model with the AWS API value.
+type Flattener interface {
+	Flatten(ctx context.Context, v any) diag.Diagnostics
 }
 
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(autoFlexer)
 
+// WithUnionMembers registers the member types of AWS SDK for Go v2 unions (tagged-union interfaces),
+// e.g. `awstypes.ConditionMemberEquals{}`, for use when expanding.
+// A nested object with exactly one non-null field is expanded to the member of the target union named
+// for that field (the union's type name, "Member" and the field name) with the field's value as the member's `Value`.
+// Flattening does not require registration as the member's type is known.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		if expander, ok := flexer.(*autoExpander); ok {
+			for _, member := range members {
+				typ := reflect.TypeOf(member)
+				if typ.Kind() == reflect.Ptr {
+					typ = typ.Elem()
+				}
+				expander.unionMembers = append(expander.unionMembers, typ)
+			}
+		}
+	}
+}
+
+// WithSmithyDocumentFunc registers the function used to expand a JSON string to a Smithy document of type T.
+// This is typically the `document.NewLazyDocument` function from an AWS SDK for Go v2 service package.
+// Flattening does not require registration as any Smithy document can be converted to a JSON string.
+func WithSmithyDocumentFunc[T smithydocument.Marshaler](f func(any) T) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		if expander, ok := flexer.(*autoExpander); ok {
+			if expander.smithyDocumentFuncs == nil {
+				expander.smithyDocumentFuncs = make(map[reflect.Type]func(string) (any, error))
+			}
+			expander.smithyDocumentFuncs[reflect.TypeOf((*T)(nil)).Elem()] = func(s string) (any, error) {
+				return tfjson.SmithyDocumentFromString(s, f)
+			}
+		}
+	}
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -86,7 +138,61 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		return diags
 	}
 
-	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+	for _, field := range structPlanFor(ctx, valFrom.Type(), valTo.Type()) {
+		toFieldVal := valTo.FieldByIndex(field.toIndex)
+		if !toFieldVal.CanSet() {
+			continue // Corresponding field value can't be changed.
+		}
+
+		diags.Append(flexer.convertField(ctx, field.opts, valFrom.Field(field.fromIndex), toFieldVal)...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", field.name))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// fieldPlan describes the conversion of a single exported field of one struct type to the corresponding field of another.
+type fieldPlan struct {
+	name      string // Name of the `from` field.
+	fromIndex int
+	toIndex   []int
+	opts      fieldOpts
+}
+
+type structPlanKey struct {
+	from, to       reflect.Type
+	resourcePrefix string
+}
+
+var (
+	// structPlans caches the []fieldPlan for each pair of struct types (and resource prefix) converted.
+	// Field matching is by name, fuzzy and expensive, and depends only on the struct types.
+	structPlans sync.Map
+)
+
+// structPlanFor returns the field conversions for struct type `typFrom` to struct type `typTo`,
+// computing and caching them on first use.
+func structPlanFor(ctx context.Context, typFrom, typTo reflect.Type) []fieldPlan {
+	resourcePrefix, _ := ctx.Value(ResourcePrefix).(string)
+	key := structPlanKey{from: typFrom, to: typTo, resourcePrefix: resourcePrefix}
+
+	if v, ok := structPlans.Load(key); ok {
+		return v.([]fieldPlan)
+	}
+
+	v, _ := structPlans.LoadOrStore(key, newStructPlan(ctx, typFrom, typTo))
+
+	return v.([]fieldPlan)
+}
+
+// newStructPlan returns the field conversions for struct type `typFrom` to struct type `typTo`.
+func newStructPlan(ctx context.Context, typFrom, typTo reflect.Type) []fieldPlan {
+	var plan []fieldPlan
+
+	for i := 0; i < typFrom.NumField(); i++ {
 		field := typFrom.Field(i)
 		if field.PkgPath != "" {
 			continue // Skip unexported fields.
@@ -98,29 +204,99 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == MapBlockKey {
 			continue
 		}
+		fromOpts := autoFlexFieldOpts(field)
+		if fromOpts.ignore {
+			continue
+		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom)
-		if !toFieldVal.IsValid() {
+		toField, ok := findField(ctx, fieldName, fromOpts, typTo, typFrom)
+		if !ok {
 			continue // Corresponding field not found in to.
 		}
-		if !toFieldVal.CanSet() {
-			continue // Corresponding field value can't be changed.
+
+		// Only Plugin Framework model fields have `autoflex` struct tags.
+		toOpts := autoFlexFieldOpts(toField)
+
+		plan = append(plan, fieldPlan{
+			name:      fieldName,
+			fromIndex: i,
+			toIndex:   toField.Index,
+			opts: fieldOpts{
+				omitempty: fromOpts.omitempty || toOpts.omitempty,
+				legacy:    fromOpts.legacy || toOpts.legacy,
+			},
+		})
+	}
+
+	return plan
+}
+
+const (
+	autoFlexTagKey = "autoflex"
+)
+
+// fieldOpts are the options specified in a Plugin Framework model field's `autoflex` struct tag,
+// e.g. `autoflex:"AwsFieldName,omitempty,legacy"` or `autoflex:"-"`.
+type fieldOpts struct {
+	// name is the name of the corresponding AWS API field, overriding fuzzy field name matching.
+	name string
+	// ignore is true if the field is not converted.
+	ignore bool
+	// omitempty is true if zero values (e.g. "") are not expanded and are flattened to null.
+	omitempty bool
+	// legacy is true if zero values are not expanded and null AWS API values are flattened to zero values,
+	// matching the behavior of resources migrated from Plugin SDK V2.
+	legacy bool
+}
+
+// autoFlexFieldOpts returns the options specified in a struct field's `autoflex` struct tag.
+func autoFlexFieldOpts(field reflect.StructField) fieldOpts {
+	var opts fieldOpts
+
+	tag, ok := field.Tag.Lookup(autoFlexTagKey)
+	if !ok {
+		return opts
+	}
+
+	if tag == "-" {
+		opts.ignore = true
+		return opts
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	opts.name = name
+	for _, option := range strings.Split(options, ",") {
+		switch option {
+		case "omitempty":
+			opts.omitempty = true
+		case "legacy":
+			opts.legacy = true
 		}
+	}
 
-		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
-		if diags.HasError() {
-			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
-			return diags
+	return opts
+}
+
+// findField returns the field of struct type `typTo` corresponding to the field named `fieldNameFrom` of struct type `typFrom`.
+// A name in either field's `autoflex` struct tag takes precedence over fuzzy name matching.
+func findField(ctx context.Context, fieldNameFrom string, fromOpts fieldOpts, typTo, typFrom reflect.Type) (reflect.StructField, bool) {
+	if fromOpts.name != "" {
+		return typTo.FieldByName(fromOpts.name)
+	}
+
+	for i := 0; i < typTo.NumField(); i++ {
+		if field := typTo.Field(i); field.PkgPath == "" && autoFlexFieldOpts(field).name == fieldNameFrom {
+			return field, true
 		}
 	}
 
-	return diags
+	return findFieldFuzzy(ctx, fieldNameFrom, typTo, typFrom)
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value) reflect.Value {
+func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typTo, typFrom reflect.Type) (reflect.StructField, bool) {
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
-		return v
+	if field, ok := fieldByName(typTo, fieldNameFrom); ok {
+		return field, true
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -130,7 +306,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 	// to make sure fuzzy matches are not in "from".
 
 	// second precedence is exact match (case insensitive)
-	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+	for i := 0; i < typTo.NumField(); i++ {
 		field := typTo.Field(i)
 		if field.PkgPath != "" {
 			continue // Skip unexported fields.
@@ -139,22 +315,22 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if fieldNameTo == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+		if field, ok := fieldByName(typTo, fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typFrom) {
 			// probably could assume validity here since reflect gave the field name
-			return v
+			return field, true
 		}
 	}
 
 	// third precedence is singular/plural
-	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
-			return v
+	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), typFrom) {
+		if field, ok := fieldByName(typTo, plural.Plural(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
-	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
-			return v
+	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), typFrom) {
+		if field, ok := fieldByName(typTo, plural.Singular(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
@@ -165,20 +341,33 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 			// so it will only recurse once
 			ctx = context.WithValue(ctx, ResourcePrefixRecurse, true)
 			if strings.HasPrefix(fieldNameFrom, v) {
-				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom)
+				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), typTo, typFrom)
 			}
-			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom)
+			return findFieldFuzzy(ctx, v+fieldNameFrom, typTo, typFrom)
 		}
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	// no finds, fuzzy or otherwise
+	return reflect.StructField{}, false
 }
 
-func fieldExistsInStruct(field string, str reflect.Value) bool {
-	if v := str.FieldByName(field); v.IsValid() {
-		return true
+// fieldByName returns the struct field with the given name.
+// Fields whose `autoflex` struct tag specifies another name, or that are ignored, are not returned.
+func fieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return field, false
 	}
 
-	return false
+	if opts := autoFlexFieldOpts(field); opts.ignore || (opts.name != "" && opts.name != name) {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+func fieldExistsInStruct(field string, typ reflect.Type) bool {
+	_, ok := typ.FieldByName(field)
+
+	return ok
 }
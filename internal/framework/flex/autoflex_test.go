@@ -4,8 +4,16 @@
 package flex
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithydocument "github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -310,3 +318,208 @@ type TestFlexMapBlockKeyTF05 struct {
 	Attr1       types.String                 `tfsdk:"attr1"`
 	Attr2       types.String                 `tfsdk:"attr2"`
 }
+
+// A union (tagged-union interface) and its members.
+type TestFlexUnion interface {
+	isTestFlexUnion()
+}
+
+type TestFlexUnionMemberString struct {
+	Value string
+}
+
+func (*TestFlexUnionMemberString) isTestFlexUnion() {}
+
+type TestFlexUnionMemberStruct struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionMemberStruct) isTestFlexUnion() {}
+
+type TestFlexUnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*TestFlexUnknownUnionMember) isTestFlexUnion() {}
+
+type TestFlexUnionTF01 struct {
+	String types.String                                  `tfsdk:"string"`
+	Struct fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"struct"`
+}
+
+type TestFlexUnionTF02 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field1"`
+}
+
+type TestFlexUnionAWS02 struct {
+	Field1 TestFlexUnion
+}
+
+type TestFlexUnionAWS03 struct {
+	Field1 []TestFlexUnion
+}
+
+// A Smithy document, ie a service package's document.Interface.
+type TestFlexDocument interface {
+	smithydocument.Marshaler
+	smithydocument.Unmarshaler
+}
+
+type testFlexDocument struct {
+	Value any
+}
+
+func newTestFlexDocument(v any) TestFlexDocument {
+	return &testFlexDocument{Value: v}
+}
+
+func (d *testFlexDocument) MarshalSmithyDocument() ([]byte, error) {
+	return json.Marshal(d.Value)
+}
+
+func (d *testFlexDocument) UnmarshalSmithyDocument(v any) error {
+	b, err := d.MarshalSmithyDocument()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+type TestFlexDocumentAWS01 struct {
+	Field1 TestFlexDocument
+}
+
+// Fields with `autoflex` struct tags.
+type TestFlexTagsTF01 struct {
+	Name     types.String `tfsdk:"name" autoflex:"FieldName"`
+	Ignored  types.String `tfsdk:"ignored" autoflex:"-"`
+	Optional types.String `tfsdk:"optional" autoflex:",omitempty"`
+	Legacy   types.String `tfsdk:"legacy" autoflex:",legacy"`
+	Count    types.Int64  `tfsdk:"count" autoflex:",legacy"`
+}
+
+type TestFlexTagsAWS01 struct {
+	FieldName *string
+	Name      *string
+	Ignored   *string
+	Optional  *string
+	Legacy    *string
+	Count     *int64
+}
+
+// A model that expands and flattens itself, upper-casing its value in the AWS API.
+type TestFlexExpanderTF01 struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+func (m TestFlexExpanderTF01) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	return &TestFlexAWS01{Field1: strings.ToUpper(m.Field1.ValueString())}, nil
+}
+
+func (m *TestFlexExpanderTF01) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case TestFlexAWS01:
+		m.Field1 = types.StringValue(strings.ToLower(v.Field1))
+	case *TestFlexAWS01:
+		m.Field1 = types.StringValue(strings.ToLower(v.Field1))
+	default:
+		diags.AddError("Flatten", "unexpected type")
+	}
+
+	return diags
+}
+
+type TestFlexExpanderTF02 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexExpanderTF01] `tfsdk:"field1"`
+}
+
+// A model whose Expand returns an incompatible value.
+type TestFlexExpanderTF03 struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+func (m TestFlexExpanderTF03) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	return m.Field1.ValueString(), nil
+}
+
+// The "uncached" benchmarks clear cached struct conversion plans before each conversion,
+// i.e. they match field names on every call as AutoFlex did before plans were cached.
+
+func BenchmarkExpand(b *testing.B) {
+	ctx := context.Background()
+	from := &TestFlexTF03{
+		Field1:  types.StringValue("field1"),
+		Field2:  types.StringValue("field2"),
+		Field3:  types.Int64Value(3),
+		Field4:  types.Int64Value(-4),
+		Field5:  types.Int64Value(5),
+		Field6:  types.Int64Value(-6),
+		Field7:  types.Float64Value(7.7),
+		Field8:  types.Float64Value(-8.8),
+		Field9:  types.Float64Value(9.99),
+		Field10: types.Float64Value(-10.101),
+		Field11: types.BoolValue(true),
+		Field12: types.BoolValue(false),
+	}
+
+	benchmarkAutoFlex(b, func() error {
+		if diags := Expand(ctx, from, &TestFlexAWS04{}); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+		return nil
+	})
+}
+
+func BenchmarkFlatten(b *testing.B) {
+	ctx := context.Background()
+	from := &TestFlexAWS04{
+		Field1:  "field1",
+		Field2:  aws.String("field2"),
+		Field3:  3,
+		Field4:  aws.Int32(-4),
+		Field5:  5,
+		Field6:  aws.Int64(-6),
+		Field7:  7.7,
+		Field8:  aws.Float32(-8.8),
+		Field9:  9.99,
+		Field10: aws.Float64(-10.101),
+		Field11: true,
+		Field12: aws.Bool(false),
+	}
+
+	benchmarkAutoFlex(b, func() error {
+		if diags := Flatten(ctx, from, &TestFlexTF03{}); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+		return nil
+	})
+}
+
+func benchmarkAutoFlex(b *testing.B, f func() error) {
+	b.Helper()
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			structPlans.Range(func(key, _ any) bool {
+				structPlans.Delete(key)
+				return true
+			})
+
+			if err := f(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := f(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
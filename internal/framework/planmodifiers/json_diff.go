@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// jsonDiffWarningModifier emits a warning describing the path-level changes to a JSON string attribute.
+type jsonDiffWarningModifier struct{}
+
+// Description describes the plan modification in plain text formatting.
+func (m jsonDiffWarningModifier) Description(_ context.Context) string {
+	return "Emits a warning describing the JSON pointers that are added, removed or changed by an update."
+}
+
+// MarkdownDescription describes the plan modification in Markdown formatting.
+func (m jsonDiffWarningModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString performs the plan modification.
+func (m jsonDiffWarningModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	// Nothing to compare on create or destroy, or if the new value is not yet known.
+	if request.StateValue.IsNull() || request.PlanValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	old, new := request.StateValue.ValueString(), request.PlanValue.ValueString()
+	if old == new {
+		return
+	}
+
+	diffs, err := verify.JSONDiff(old, new)
+
+	// Invalid JSON is reported by validators.
+	if err != nil || len(diffs) == 0 {
+		return
+	}
+
+	response.Diagnostics.AddAttributeWarning(
+		request.Path,
+		fmt.Sprintf("JSON value of %s changed", request.Path),
+		verify.JSONDiffDetail(diffs),
+	)
+}
+
+// JSONDiffWarning returns a plan modifier that emits a warning diagnostic
+// containing the path-level semantic differences between the prior state value
+// and the planned value of a JSON string attribute.
+//
+// Null (create or destroy) and unknown (known after apply) values are skipped.
+func JSONDiffWarning() planmodifier.String {
+	return jsonDiffWarningModifier{}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwplanmodifiers "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
+)
+
+func TestJSONDiffWarning(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		stateValue          types.String
+		planValue           types.String
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"create": {
+			stateValue: types.StringNull(),
+			planValue:  types.StringValue(`{"a": 1}`),
+		},
+		"destroy": {
+			stateValue: types.StringValue(`{"a": 1}`),
+			planValue:  types.StringNull(),
+		},
+		"unknown plan": {
+			stateValue: types.StringValue(`{"a": 1}`),
+			planValue:  types.StringUnknown(),
+		},
+		"semantically equal": {
+			stateValue: types.StringValue(`{"a": 1, "b": 2}`),
+			planValue:  types.StringValue(`{"b": 2, "a": 1}`),
+		},
+		"invalid JSON": {
+			stateValue: types.StringValue(`{"a": 1}`),
+			planValue:  types.StringValue(`{"a": `),
+		},
+		"changed": {
+			stateValue: types.StringValue(`{"a": 1, "b": 2}`),
+			planValue:  types.StringValue(`{"a": 2, "c": 3}`),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"JSON value of test changed",
+					"Changes by JSON pointer (+ added, - removed, ~ changed):\n\n~ /a: 1 -> 2\n- /b: 2\n+ /c: 3",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := planmodifier.StringRequest{
+				Path:       path.Root("test"),
+				StateValue: test.stateValue,
+				PlanValue:  test.planValue,
+			}
+			response := planmodifier.StringResponse{
+				PlanValue: test.planValue,
+			}
+			fwplanmodifiers.JSONDiffWarning().PlanModifyString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNBuildFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition, service, region, accountID, resource string
+		expected                                        attr.Value
+		expectedError                                   bool
+	}{
+		"IAM role": {
+			partition: "aws",
+			service:   "iam",
+			accountID: "444455556666",
+			resource:  "role/example",
+			expected:  types.StringValue("arn:aws:iam::444455556666:role/example"), // lintignore:AWSAT005
+		},
+		"regional resource": {
+			partition: "aws",
+			service:   "rds",
+			region:    "us-east-1", // lintignore:AWSAT003
+			accountID: "123456789012",
+			resource:  "db:test",
+			expected:  types.StringValue("arn:aws:rds:us-east-1:123456789012:db:test"), // lintignore:AWSAT003,AWSAT005
+		},
+		"S3 bucket": {
+			partition: "aws",
+			service:   "s3",
+			resource:  "example",
+			expected:  types.StringValue("arn:aws:s3:::example"), // lintignore:AWSAT005
+		},
+		"missing service": {
+			partition:     "aws",
+			resource:      "example",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(testCase.partition),
+					types.StringValue(testCase.service),
+					types.StringValue(testCase.region),
+					types.StringValue(testCase.accountID),
+					types.StringValue(testCase.resource),
+				}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			tffunction.NewARNBuildFunction().Run(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectedError; got != want {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if testCase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(response.Result.Value(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var arnParseResultAttrTypes = map[string]attr.Type{
+	"account_id": types.StringType,
+	"partition":  types.StringType,
+	"region":     types.StringType,
+	"resource":   types.StringType,
+	"service":    types.StringType,
+}
+
+var _ function.Function = arnParseFunction{}
+
+func NewARNParseFunction() function.Function {
+	return &arnParseFunction{}
+}
+
+type arnParseFunction struct{}
+
+func (f arnParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_parse"
+}
+
+func (f arnParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "arn_parse Function",
+		MarkdownDescription: "Parses an ARN into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:       "arn",
+				CustomType: fwtypes.ARNType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: arnParseResultAttrTypes,
+		},
+	}
+}
+
+func (f arnParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var data fwtypes.ARN
+
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// fwtypes.ARNType maps values which cannot be parsed as an ARN to Unknown.
+	if data.IsUnknown() {
+		resp.Diagnostics.AddError("Invalid ARN", "The value provided cannot be parsed as an ARN.")
+		return
+	}
+
+	arn := data.ValueARN()
+
+	value := map[string]attr.Value{
+		"account_id": types.StringValue(arn.AccountID),
+		"partition":  types.StringValue(arn.Partition),
+		"region":     types.StringValue(arn.Region),
+		"resource":   types.StringValue(arn.Resource),
+		"service":    types.StringValue(arn.Service),
+	}
+
+	result, d := types.ObjectValue(arnParseResultAttrTypes, value)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, result)...)
+}
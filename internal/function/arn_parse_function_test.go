@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNParseFunction(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"account_id": types.StringType,
+		"partition":  types.StringType,
+		"region":     types.StringType,
+		"resource":   types.StringType,
+		"service":    types.StringType,
+	}
+
+	testCases := map[string]struct {
+		arg           attr.Value
+		expected      attr.Value
+		expectedError bool
+	}{
+		"valid ARN": {
+			arg: fwtypes.ARNValue("arn:aws:iam::444455556666:role/example"), // lintignore:AWSAT005
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"account_id": types.StringValue("444455556666"),
+				"partition":  types.StringValue("aws"),
+				"region":     types.StringValue(""),
+				"resource":   types.StringValue("role/example"),
+				"service":    types.StringValue("iam"),
+			}),
+		},
+		"valid ARN with region": {
+			arg: fwtypes.ARNValue("arn:aws:rds:us-east-1:123456789012:db:test"), // lintignore:AWSAT003,AWSAT005
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"account_id": types.StringValue("123456789012"),
+				"partition":  types.StringValue("aws"),
+				"region":     types.StringValue("us-east-1"), // lintignore:AWSAT003
+				"resource":   types.StringValue("db:test"),
+				"service":    types.StringValue("rds"),
+			}),
+		},
+		"invalid ARN": {
+			arg:           fwtypes.ARNUnknown(),
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{testCase.arg}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(attrTypes)),
+			}
+
+			tffunction.NewARNParseFunction().Run(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectedError; got != want {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if testCase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(response.Result.Value(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,861 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	sdkV2ServicePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
+var (
+	force          = flag.Bool("Force", false, "whether to overwrite an existing file")
+	input          = flag.String("Input", "", "name of the AWS SDK for Go v2 input struct, e.g. CreateAgentInput")
+	output         = flag.String("Output", "", "name of the AWS SDK for Go v2 struct describing the resource, e.g. Agent; fields not in the input are computed")
+	resourceName   = flag.String("Resource", "", "resource name in PascalCase, defaults to the input struct name without Create and Input, e.g. Agent")
+	servicePackage = flag.String("Service", "", "provider service package, defaults to $GOPACKAGE")
+	update         = flag.String("Update", "", "name of the AWS SDK for Go v2 update input struct, e.g. UpdateAgentInput; input fields not in it require replacement")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-schema-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+//go:embed file.tmpl
+var fileTmpl string
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if *input == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	service := *servicePackage
+	if service == "" {
+		service = os.Getenv("GOPACKAGE")
+	}
+	if service == "" {
+		g.Fatalf("service package not specified")
+	}
+
+	awsPackage, err := names.AWSGoV2Package(service)
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	resource := *resourceName
+	if resource == "" {
+		resource = strings.TrimSuffix(strings.TrimPrefix(*input, "Create"), "Input")
+	}
+
+	filename := fmt.Sprintf("%s_schema.go", toSnakeCase(resource))
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !*force {
+		g.Fatalf("file (%s) already exists and -Force is not set", filename)
+	}
+
+	sdk, err := loadSDKPackage(sdkV2ServicePathPrefix + awsPackage)
+	if err != nil {
+		g.Fatalf("loading AWS SDK for Go v2 package (%s): %s", awsPackage, err)
+	}
+
+	b := newBuilder(sdk)
+	td, err := b.build(resource, *input, *output, *update)
+	if err != nil {
+		g.Fatalf("generating schema: %s", err)
+	}
+
+	td.Parameters = strings.Join(os.Args[1:], " ")
+	td.PackageName = service
+
+	for _, warning := range b.warnings {
+		g.Warnf("%s", warning)
+	}
+
+	g.Infof("Generating internal/service/%s/%s", service, filename)
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("schema", fileTmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type TemplateData struct {
+	Parameters   string
+	PackageName  string
+	Imports      []Import
+	ResourceType string
+	Attributes   []*Attribute
+	Blocks       []*Block
+	Models       []*Model
+	Unions       []*Union
+}
+
+type Import struct {
+	Alias    string
+	Path     string
+	Standard bool // Whether the import is from the standard library.
+}
+
+// Attribute is a schema attribute.
+type Attribute struct {
+	Key              string // Go expression for the attribute's name.
+	Name             string
+	Expr             string // Predefined attribute, e.g. `framework.IDAttribute()`.
+	Type             string // e.g. `schema.StringAttribute`.
+	CustomType       string
+	ElementType      string
+	Required         bool
+	Optional         bool
+	Computed         bool
+	PlanModifierType string // e.g. `String`.
+	PlanModifiers    []string
+}
+
+// Block is a schema list nested block.
+type Block struct {
+	Key           string
+	Name          string
+	CustomType    string
+	PlanModifiers []string
+	Validators    []string
+	Attributes    []*Attribute
+	Blocks        []*Block
+}
+
+// Model is a Terraform Plugin Framework model struct.
+type Model struct {
+	Name   string
+	Fields []*Field
+}
+
+type Field struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+// Union is the AutoFlex option registering the members of an AWS SDK for Go v2 union.
+type Union struct {
+	Name      string
+	Interface string
+	Members   []string
+}
+
+// sdkPackage is an AWS SDK for Go v2 service package and its types package.
+type sdkPackage struct {
+	service *types.Package
+	types   *types.Package
+	// required is the set of required field names of each struct, from the service package's parameter validators.
+	required map[string]map[string]bool
+}
+
+func loadSDKPackage(path string) (*sdkPackage, error) {
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	service, err := imp.Import(path)
+	if err != nil {
+		return nil, err
+	}
+
+	awsTypes, err := imp.Import(path + "/types")
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := build.Import(path, ".", build.FindOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSDKPackage(fset, service, awsTypes, filepath.Join(pkg.Dir, "validators.go"))
+}
+
+func newSDKPackage(fset *token.FileSet, service, awsTypes *types.Package, validatorsFilename string) (*sdkPackage, error) {
+	required, err := parseRequiredFields(fset, validatorsFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdkPackage{
+		service:  service,
+		types:    awsTypes,
+		required: required,
+	}, nil
+}
+
+// parseRequiredFields returns the required field names of each struct validated in an AWS SDK for Go v2 validators.go file.
+// Validation functions (validateOpCreateAgentInput, validatePromptConfiguration etc.) take a single struct pointer parameter
+// and call smithy.NewErrParamRequired for each required field.
+func parseRequiredFields(fset *token.FileSet, filename string) (map[string]map[string]bool, error) {
+	f, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]map[string]bool)
+
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !strings.HasPrefix(fn.Name.Name, "validate") || fn.Body == nil || len(fn.Type.Params.List) != 1 {
+			continue
+		}
+
+		star, ok := fn.Type.Params.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		var typeName string
+		switch x := star.X.(type) {
+		case *ast.Ident:
+			typeName = x.Name
+		case *ast.SelectorExpr:
+			typeName = x.Sel.Name
+		default:
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "NewErrParamRequired" {
+				return true
+			}
+
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if name, err := strconv.Unquote(lit.Value); err == nil {
+					if required[typeName] == nil {
+						required[typeName] = make(map[string]bool)
+					}
+					required[typeName][name] = true
+				}
+			}
+
+			return true
+		})
+	}
+
+	return required, nil
+}
+
+// lookup returns the named struct or interface type in the service or types package.
+func (p *sdkPackage) lookup(name string) (*types.Named, error) {
+	for _, pkg := range []*types.Package{p.service, p.types} {
+		if obj, ok := pkg.Scope().Lookup(name).(*types.TypeName); ok {
+			if named, ok := obj.Type().(*types.Named); ok {
+				return named, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("type (%s) not found in %s or %s", name, p.service.Path(), p.types.Path())
+}
+
+// fieldUse describes how a struct field is used by the resource.
+type fieldUse struct {
+	required        bool
+	computed        bool
+	identifier      bool // Computed value that never changes, e.g. an ARN.
+	requiresReplace bool
+}
+
+type builder struct {
+	sdk      *sdkPackage
+	imports  map[string]string // Import path to alias.
+	models   map[string]*Model // By model name.
+	unions   map[string]*Union // By model name.
+	path     []string          // Names of the nested types being built, to detect recursion.
+	warnings []string
+}
+
+func newBuilder(sdk *sdkPackage) *builder {
+	return &builder{
+		sdk:     sdk,
+		imports: make(map[string]string),
+		models:  make(map[string]*Model),
+		unions:  make(map[string]*Union),
+	}
+}
+
+func (b *builder) build(resource, inputName, outputName, updateName string) (*TemplateData, error) {
+	inputType, err := b.sdk.lookup(inputName)
+	if err != nil {
+		return nil, err
+	}
+
+	inputStruct, ok := inputType.Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", inputName)
+	}
+
+	var outputStruct, updateStruct *types.Struct
+	if outputName != "" {
+		outputType, err := b.sdk.lookup(outputName)
+		if err != nil {
+			return nil, err
+		}
+		if outputStruct, ok = outputType.Underlying().(*types.Struct); !ok {
+			return nil, fmt.Errorf("%s is not a struct", outputName)
+		}
+	}
+	if updateName != "" {
+		updateType, err := b.sdk.lookup(updateName)
+		if err != nil {
+			return nil, err
+		}
+		if updateStruct, ok = updateType.Underlying().(*types.Struct); !ok {
+			return nil, fmt.Errorf("%s is not a struct", updateName)
+		}
+	}
+
+	b.use("context", "")
+	b.use("github.com/hashicorp/terraform-plugin-framework/resource", "")
+	b.use("github.com/hashicorp/terraform-plugin-framework/resource/schema", "")
+	b.use("github.com/hashicorp/terraform-plugin-framework/types", "")
+	b.use("github.com/hashicorp/terraform-provider-aws/internal/framework", "")
+	b.use("github.com/hashicorp/terraform-provider-aws/names", "")
+
+	modelName := fmt.Sprintf("resource%sData", resource)
+	model := &Model{Name: modelName}
+	b.models[modelName] = model
+
+	td := &TemplateData{
+		ResourceType: fmt.Sprintf("resource%s", resource),
+	}
+
+	td.Attributes = append(td.Attributes, &Attribute{Key: "names.AttrID", Name: "id", Expr: "framework.IDAttribute()"})
+	model.Fields = append(model.Fields, &Field{Name: "ID", Type: "types.String", Tag: "id"})
+
+	add := func(v *types.Var, use fieldUse) {
+		if v.Name() == "Tags" {
+			b.use("github.com/hashicorp/terraform-provider-aws/internal/tags", "tftags")
+			td.Attributes = append(td.Attributes,
+				&Attribute{Key: "names.AttrTags", Name: "tags", Expr: "tftags.TagsAttribute()"},
+				&Attribute{Key: "names.AttrTagsAll", Name: "tags_all", Expr: "tftags.TagsAttributeComputedOnly()"},
+			)
+			model.Fields = append(model.Fields,
+				&Field{Name: "Tags", Type: "types.Map", Tag: "tags"},
+				&Field{Name: "TagsAll", Type: "types.Map", Tag: "tags_all"},
+			)
+			return
+		}
+
+		attribute, block, field := b.field(v, use)
+		if field == nil {
+			return
+		}
+
+		model.Fields = append(model.Fields, field)
+		if attribute != nil {
+			td.Attributes = append(td.Attributes, attribute)
+		}
+		if block != nil {
+			td.Blocks = append(td.Blocks, block)
+		}
+	}
+
+	required := b.sdk.required[inputName]
+	for _, v := range exportedFields(inputStruct) {
+		add(v, fieldUse{
+			required:        required[v.Name()],
+			requiresReplace: updateStruct != nil && !hasField(updateStruct, v.Name()),
+		})
+	}
+
+	if outputStruct != nil {
+		for _, v := range exportedFields(outputStruct) {
+			if !hasField(inputStruct, v.Name()) {
+				add(v, fieldUse{computed: true, identifier: isIdentifier(v.Name())})
+			}
+		}
+	}
+
+	slices.SortFunc(td.Attributes, func(a, b *Attribute) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(td.Blocks, func(a, b *Block) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, name := range sortedKeys(b.models) {
+		model := b.models[name]
+		slices.SortFunc(model.Fields, func(a, b *Field) int { return strings.Compare(a.Name, b.Name) })
+		if name == modelName {
+			td.Models = append([]*Model{model}, td.Models...)
+		} else {
+			td.Models = append(td.Models, model)
+		}
+	}
+
+	for _, name := range sortedKeys(b.unions) {
+		td.Unions = append(td.Unions, b.unions[name])
+	}
+
+	for _, path := range sortedKeys(b.imports) {
+		td.Imports = append(td.Imports, Import{Alias: b.imports[path], Path: path, Standard: !strings.Contains(path, ".")})
+	}
+
+	return td, nil
+}
+
+// field returns the schema attribute or block and the model field for a struct field.
+// A nil model field is returned for unsupported fields.
+func (b *builder) field(v *types.Var, use fieldUse) (*Attribute, *Block, *Field) {
+	name := toSnakeCase(v.Name())
+	key := strconv.Quote(name)
+	field := &Field{Name: toGoName(v.Name()), Tag: name}
+
+	t := v.Type()
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	switch {
+	case isIgnored(v):
+		return nil, nil, nil
+
+	case isTime(t):
+		b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+		field.Type = "fwtypes.Timestamp"
+		return b.attribute(key, name, "schema.StringAttribute", "fwtypes.TimestampType", "", "String", use), nil, field
+
+	case isEnum(t):
+		b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+		enum := b.qualified(t.(*types.Named))
+		field.Type = fmt.Sprintf("fwtypes.StringEnum[%s]", enum)
+		return b.attribute(key, name, "schema.StringAttribute", fmt.Sprintf("fwtypes.StringEnumType[%s]()", enum), "", "String", use), nil, field
+
+	case isDocument(t):
+		// Smithy documents are JSON strings. Expand with flex.WithSmithyDocumentFunc(document.NewLazyDocument).
+		field.Type = "types.String"
+		return b.attribute(key, name, "schema.StringAttribute", "", "", "String", use), nil, field
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsString != 0 && isARN(v.Name()):
+			b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+			field.Type = "fwtypes.ARN"
+			return b.attribute(key, name, "schema.StringAttribute", "fwtypes.ARNType", "", "String", use), nil, field
+
+		case u.Info()&types.IsString != 0:
+			field.Type = "types.String"
+			return b.attribute(key, name, "schema.StringAttribute", "", "", "String", use), nil, field
+
+		case u.Info()&types.IsBoolean != 0:
+			field.Type = "types.Bool"
+			return b.attribute(key, name, "schema.BoolAttribute", "", "", "Bool", use), nil, field
+
+		case u.Info()&types.IsInteger != 0:
+			field.Type = "types.Int64"
+			return b.attribute(key, name, "schema.Int64Attribute", "", "", "Int64", use), nil, field
+
+		case u.Info()&types.IsFloat != 0:
+			field.Type = "types.Float64"
+			return b.attribute(key, name, "schema.Float64Attribute", "", "", "Float64", use), nil, field
+		}
+
+	case *types.Slice:
+		elem := u.Elem()
+		if p, ok := elem.(*types.Pointer); ok {
+			elem = p.Elem()
+		}
+
+		switch {
+		case isEnum(elem):
+			b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+			enum := b.qualified(elem.(*types.Named))
+			field.Type = fmt.Sprintf("fwtypes.SetValueOf[fwtypes.StringEnum[%s]]", enum)
+			return b.attribute(key, name, "schema.SetAttribute", fmt.Sprintf("fwtypes.NewSetTypeOf[fwtypes.StringEnum[%[1]s]](ctx)", enum), fmt.Sprintf("fwtypes.StringEnumType[%s]()", enum), "Set", use), nil, field
+
+		case isStruct(elem) || isUnion(elem):
+			return b.nested(elem.(*types.Named), key, name, field, false, use)
+		}
+
+		if basic, ok := elem.Underlying().(*types.Basic); ok && basic.Info()&types.IsString != 0 {
+			b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+			if isARN(v.Name()) {
+				field.Type = "fwtypes.ListValueOf[fwtypes.ARN]"
+				return b.attribute(key, name, "schema.ListAttribute", "fwtypes.ListOfARNType", "fwtypes.ARNType", "List", use), nil, field
+			}
+			field.Type = "fwtypes.ListValueOf[types.String]"
+			return b.attribute(key, name, "schema.ListAttribute", "fwtypes.ListOfStringType", "types.StringType", "List", use), nil, field
+		}
+
+	case *types.Map:
+		key, keyOK := u.Key().Underlying().(*types.Basic)
+		elem, elemOK := u.Elem().Underlying().(*types.Basic)
+		if keyOK && elemOK && key.Info()&types.IsString != 0 && elem.Info()&types.IsString != 0 {
+			b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+			field.Type = "fwtypes.MapValueOf[types.String]"
+			return b.attribute(strconv.Quote(name), name, "schema.MapAttribute", "fwtypes.MapOfStringType", "types.StringType", "Map", use), nil, field
+		}
+
+	case *types.Struct, *types.Interface:
+		if named, ok := t.(*types.Named); ok && (isStruct(named) || isUnion(named)) {
+			return b.nested(named, key, name, field, true, use)
+		}
+	}
+
+	b.warnings = append(b.warnings, fmt.Sprintf("field (%s): unsupported type %s", v.Name(), v.Type()))
+
+	return nil, nil, nil
+}
+
+// attribute returns a schema attribute.
+func (b *builder) attribute(key, name, typ, customType, elementType, planModifierType string, use fieldUse) *Attribute {
+	attribute := &Attribute{
+		Key:              key,
+		Name:             name,
+		Type:             typ,
+		CustomType:       customType,
+		ElementType:      elementType,
+		PlanModifierType: planModifierType,
+	}
+
+	switch {
+	case use.computed:
+		attribute.Computed = true
+		// Other computed values may change on update and are left unknown.
+		if use.identifier {
+			attribute.PlanModifiers = append(attribute.PlanModifiers, b.planModifier(planModifierType, "UseStateForUnknown"))
+		}
+	case use.required:
+		attribute.Required = true
+	default:
+		attribute.Optional = true
+	}
+
+	if use.requiresReplace {
+		attribute.PlanModifiers = append(attribute.PlanModifiers, b.planModifier(planModifierType, "RequiresReplace"))
+	}
+
+	if len(attribute.PlanModifiers) > 0 {
+		b.use("github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier", "")
+	}
+
+	return attribute
+}
+
+// nested returns the schema block (or computed attribute) and model field for a nested struct or union.
+func (b *builder) nested(named *types.Named, key, name string, field *Field, single bool, use fieldUse) (*Attribute, *Block, *Field) {
+	typeName := named.Obj().Name()
+	if slices.Contains(b.path, typeName) {
+		b.warnings = append(b.warnings, fmt.Sprintf("field (%s): recursive type %s", field.Name, typeName))
+		return nil, nil, nil
+	}
+
+	b.path = append(b.path, typeName)
+	defer func() { b.path = b.path[:len(b.path)-1] }()
+
+	b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+
+	modelName, attributes, blocks := b.model(named)
+	field.Type = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName)
+	customType := fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", modelName)
+
+	if use.computed {
+		return b.attribute(key, name, "schema.ListAttribute", customType, fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", modelName), "List", use), nil, field
+	}
+
+	block := &Block{
+		Key:        key,
+		Name:       name,
+		CustomType: customType,
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	if single {
+		block.Validators = append(block.Validators, "listvalidator.SizeAtMost(1)")
+	}
+	if use.required {
+		block.Validators = append(block.Validators, "listvalidator.IsRequired()")
+	}
+	if len(block.Validators) > 0 {
+		b.use("github.com/hashicorp/terraform-plugin-framework-validators/listvalidator", "")
+		b.use("github.com/hashicorp/terraform-plugin-framework/schema/validator", "")
+	}
+
+	if use.requiresReplace {
+		block.PlanModifiers = append(block.PlanModifiers, b.planModifier("List", "RequiresReplace"))
+		b.use("github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier", "")
+	}
+
+	return nil, block, field
+}
+
+// model returns the name of the model for a nested struct or union, creating it if necessary, and the nested schema.
+func (b *builder) model(named *types.Named) (string, []*Attribute, []*Block) {
+	typeName := named.Obj().Name()
+	modelName := fmt.Sprintf("%s%sData", strings.ToLower(typeName[:1]), typeName[1:])
+
+	model, exists := b.models[modelName]
+	if !exists {
+		model = &Model{Name: modelName}
+		b.models[modelName] = model
+	}
+
+	var attributes []*Attribute
+	var blocks []*Block
+	add := func(attribute *Attribute, block *Block, field *Field) {
+		if field == nil {
+			return
+		}
+		if !exists {
+			model.Fields = append(model.Fields, field)
+		}
+		if attribute != nil {
+			attributes = append(attributes, attribute)
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+
+	if s, ok := named.Underlying().(*types.Struct); ok {
+		required := b.sdk.required[typeName]
+		for _, v := range exportedFields(s) {
+			add(b.field(v, fieldUse{required: required[v.Name()]}))
+		}
+	} else {
+		// Union members are named for the union, e.g. ConditionMemberEquals, and have a single Value field.
+		union := &Union{
+			Name:      fmt.Sprintf("%sUnionMembers", modelName[:len(modelName)-len("Data")]),
+			Interface: b.qualified(named),
+		}
+		pkg := named.Obj().Pkg()
+		for _, name := range pkg.Scope().Names() {
+			memberName, ok := strings.CutPrefix(name, typeName+"Member")
+			if !ok {
+				continue
+			}
+
+			member, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+
+			s, ok := member.Type().Underlying().(*types.Struct)
+			if !ok || s.NumFields() == 0 || s.Field(0).Name() != "Value" {
+				continue
+			}
+
+			union.Members = append(union.Members, fmt.Sprintf("&%s{}", b.qualified(member.Type().(*types.Named))))
+			add(b.field(types.NewField(token.NoPos, pkg, memberName, s.Field(0).Type(), false), fieldUse{}))
+		}
+
+		b.unions[modelName] = union
+		b.use("github.com/hashicorp/terraform-provider-aws/internal/framework/flex", "")
+	}
+
+	slices.SortFunc(attributes, func(a, b *Attribute) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(blocks, func(a, b *Block) int { return strings.Compare(a.Name, b.Name) })
+
+	return modelName, attributes, blocks
+}
+
+// planModifier returns a plan modifier of the specified attribute type, e.g. `stringplanmodifier.RequiresReplace()`.
+func (b *builder) planModifier(planModifierType, name string) string {
+	pkg := strings.ToLower(planModifierType) + "planmodifier"
+	b.use("github.com/hashicorp/terraform-plugin-framework/resource/schema/"+pkg, "")
+
+	return fmt.Sprintf("%s.%s()", pkg, name)
+}
+
+// qualified returns the qualified Go name of an AWS SDK for Go v2 type, e.g. `awstypes.AgentStatus`.
+func (b *builder) qualified(named *types.Named) string {
+	pkg := named.Obj().Pkg()
+
+	if pkg.Path() == b.sdk.types.Path() {
+		b.use(pkg.Path(), "awstypes")
+		return "awstypes." + named.Obj().Name()
+	}
+
+	b.use(pkg.Path(), "")
+	return pkg.Name() + "." + named.Obj().Name()
+}
+
+func (b *builder) use(path, alias string) {
+	b.imports[path] = alias
+}
+
+// exportedFields returns a struct's exported fields, excluding those that are not part of a resource's schema.
+func exportedFields(s *types.Struct) []*types.Var {
+	var fields []*types.Var
+
+	for i := 0; i < s.NumFields(); i++ {
+		if v := s.Field(i); v.Exported() && !v.Embedded() {
+			fields = append(fields, v)
+		}
+	}
+
+	return fields
+}
+
+func hasField(s *types.Struct, name string) bool {
+	for i := 0; i < s.NumFields(); i++ {
+		if s.Field(i).Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIgnored(v *types.Var) bool {
+	switch v.Name() {
+	case "ClientToken", "MaxResults", "NextToken", "ResultMetadata":
+		return true
+	}
+
+	return false
+}
+
+func isARN(name string) bool {
+	return strings.HasSuffix(name, "Arn") || strings.HasSuffix(name, "ARN") || strings.HasSuffix(name, "Arns") || strings.HasSuffix(name, "ARNs")
+}
+
+// isIdentifier returns whether the field is a single resource identifier, i.e. an ARN or ID, whose value never changes.
+func isIdentifier(name string) bool {
+	return strings.HasSuffix(name, "Arn") || strings.HasSuffix(name, "ARN") || strings.HasSuffix(name, "Id") || strings.HasSuffix(name, "ID")
+}
+
+func isTime(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// isEnum returns whether the type is an AWS SDK for Go v2 enum, i.e. a string type with a Values method.
+func isEnum(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Kind() != types.String {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(named, false, named.Obj().Pkg(), "Values")
+	_, ok = obj.(*types.Func)
+
+	return ok
+}
+
+// isDocument returns whether the type is a Smithy document.
+func isDocument(t types.Type) bool {
+	if _, ok := t.Underlying().(*types.Interface); !ok {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(t, false, nil, "MarshalSmithyDocument")
+	_, ok := obj.(*types.Func)
+
+	return ok
+}
+
+func isStruct(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || isTime(named) {
+		return false
+	}
+
+	_, ok = named.Underlying().(*types.Struct)
+
+	return ok
+}
+
+// isUnion returns whether the type is an AWS SDK for Go v2 union, i.e. an interface that is not a Smithy document.
+func isUnion(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	_, ok = named.Underlying().(*types.Interface)
+
+	return ok && !isDocument(named)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// toGoName returns an AWS SDK for Go v2 field name with Go initialisms, e.g. AgentId becomes AgentID and RoleArns becomes RoleARNs.
+// An initialism must be followed by an upper case letter, a digit or the end of the name, so that e.g. Identity is unchanged.
+// AutoFlex matches model and AWS SDK for Go v2 field names case-insensitively.
+func toGoName(str string) string {
+	var sb strings.Builder
+	last := 0
+
+	for _, m := range regexache.MustCompile(`(Arn|Id)s?`).FindAllStringSubmatchIndex(str, -1) {
+		if end := m[1]; end < len(str) && !unicode.IsUpper(rune(str[end])) && !unicode.IsDigit(rune(str[end])) {
+			continue
+		}
+
+		sb.WriteString(str[last:m[2]])
+		sb.WriteString(strings.ToUpper(str[m[2]:m[3]]))
+		last = m[3]
+	}
+
+	sb.WriteString(str[last:])
+
+	return sb.String()
+}
+
+func toSnakeCase(str string) string {
+	result := regexache.MustCompile("(.)([A-Z][a-z]+)").ReplaceAllString(str, "${1}_${2}")
+	result = regexache.MustCompile("([0-9a-z])([A-Z])").ReplaceAllString(result, "${1}_${2}")
+	return strings.ToLower(result)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "whether to update the golden files in testdata")
+
+const (
+	fixtureDir       = "testdata/fixture"
+	fixtureTypesPath = "github.com/hashicorp/terraform-provider-aws/internal/generate/frameworkschema/testdata/fixture/types"
+)
+
+// loadFixtureSDKPackage type checks the AWS SDK for Go v2 service package fixture in testdata.
+func loadFixtureSDKPackage(t *testing.T) *sdkPackage {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	stdlib := importer.ForCompiler(fset, "source", nil)
+
+	check := func(path string, imp types.Importer, filenames ...string) *types.Package {
+		t.Helper()
+
+		var files []*ast.File
+		for _, filename := range filenames {
+			f, err := parser.ParseFile(fset, filename, nil, 0)
+			if err != nil {
+				t.Fatalf("parsing %s: %s", filename, err)
+			}
+			files = append(files, f)
+		}
+
+		pkg, err := (&types.Config{Importer: imp}).Check(path, fset, files, nil)
+		if err != nil {
+			t.Fatalf("type checking %s: %s", path, err)
+		}
+
+		return pkg
+	}
+
+	awsTypes := check(fixtureTypesPath, stdlib, filepath.Join(fixtureDir, "types", "types.go"))
+	service := check(filepath.Dir(fixtureTypesPath), importerFunc(func(path string) (*types.Package, error) {
+		if path == fixtureTypesPath {
+			return awsTypes, nil
+		}
+		return stdlib.Import(path)
+	}), filepath.Join(fixtureDir, "api.go"))
+
+	sdk, err := newSDKPackage(fset, service, awsTypes, filepath.Join(fixtureDir, "validators.go"))
+	if err != nil {
+		t.Fatalf("loading fixture: %s", err)
+	}
+
+	return sdk
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+func TestGenerateGolden(t *testing.T) {
+	t.Parallel()
+
+	b := newBuilder(loadFixtureSDKPackage(t))
+	td, err := b.build("Widget", "CreateWidgetInput", "Widget", "UpdateWidgetInput")
+	if err != nil {
+		t.Fatalf("build: %s", err)
+	}
+
+	td.Parameters = "-Service fixture -Input CreateWidgetInput -Output Widget -Update UpdateWidgetInput"
+	td.PackageName = "fixture"
+
+	wantWarnings := []string{
+		"field (Parts): recursive type Part",
+		"field (Settings): unsupported type map[string]int32",
+	}
+	if diff := cmp.Diff(b.warnings, wantWarnings); diff != "" {
+		t.Errorf("unexpected warnings diff (+wanted, -got): %s", diff)
+	}
+
+	filename := filepath.Join(t.TempDir(), "widget_schema.go")
+	d := common.NewGenerator().NewGoFileDestination(filename)
+	if err := d.WriteTemplate("schema", fileTmpl, td); err != nil {
+		t.Fatalf("generating file: %s", err)
+	}
+	if err := d.Write(); err != nil {
+		t.Fatalf("writing file: %s", err)
+	}
+
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "widget_schema.go.golden")
+	if *updateGolden {
+		if err := os.WriteFile(golden, got, 0644); err != nil { //nolint:gomnd
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got), run with -update-golden to update: %s", diff)
+	}
+}
+
+func TestToGoName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":             "Name",
+		"AgentId":          "AgentID",
+		"AgentArn":         "AgentARN",
+		"RoleArns":         "RoleARNs",
+		"SubnetIds":        "SubnetIDs",
+		"IdArn":            "IDARN",
+		"KmsKeyIdentifier": "KmsKeyIdentifier",
+		"Identity":         "Identity",
+		"ArnPrefix":        "ARNPrefix",
+		"Id2":              "ID2",
+	}
+
+	for input, want := range testCases {
+		if got := toGoName(input); got != want {
+			t.Errorf("toGoName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fixture is a minimal AWS SDK for Go v2 service package used as a frameworkschema test fixture.
+package fixture
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/frameworkschema/testdata/fixture/types"
+)
+
+type CreateWidgetInput struct {
+	ClientToken      *string
+	Color            types.Color
+	Description      *string
+	Dimensions       *types.Dimensions
+	Enabled          *bool
+	Name             *string
+	Parts            []types.Part
+	RoleArn          *string
+	Settings         map[string]int32
+	Source           types.Source
+	SubnetIds        []string
+	SupportedColors  []types.Color
+	Tags             map[string]string
+	noSmithyDocument struct{}
+}
+
+type UpdateWidgetInput struct {
+	WidgetId    *string
+	Description *string
+	Enabled     *bool
+	Parts       []types.Part
+	Source      types.Source
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package types is a minimal AWS SDK for Go v2 service types package used as a frameworkschema test fixture.
+package types
+
+import (
+	"time"
+)
+
+type Color string
+
+const (
+	ColorRed  Color = "RED"
+	ColorBlue Color = "BLUE"
+)
+
+func (Color) Values() []Color {
+	return []Color{
+		"RED",
+		"BLUE",
+	}
+}
+
+type WidgetStatus string
+
+const (
+	WidgetStatusCreating WidgetStatus = "CREATING"
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+)
+
+func (WidgetStatus) Values() []WidgetStatus {
+	return []WidgetStatus{
+		"CREATING",
+		"ACTIVE",
+	}
+}
+
+type Dimensions struct {
+	Height *int32
+	Width  *int32
+	Scale  *float64
+}
+
+type Part struct {
+	Name       *string
+	PartArn    *string
+	Dimensions *Dimensions
+	Parts      []Part
+}
+
+type S3Location struct {
+	BucketName *string
+	Key        *string
+}
+
+// Source is a union.
+type Source interface {
+	isSource()
+}
+
+type SourceMemberS3Location struct {
+	Value S3Location
+}
+
+func (*SourceMemberS3Location) isSource() {}
+
+type SourceMemberUrl struct {
+	Value string
+}
+
+func (*SourceMemberUrl) isSource() {}
+
+type Widget struct {
+	Color            Color
+	CreatedAt        *time.Time
+	Description      *string
+	Identity         *string
+	Name             *string
+	Status           WidgetStatus
+	StatusReasons    []string
+	WidgetArn        *string
+	WidgetId         *string
+	WidgetVersionIds []string
+}
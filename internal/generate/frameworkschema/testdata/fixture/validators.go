@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fixture
+
+import (
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/frameworkschema/testdata/fixture/types"
+)
+
+func validateDimensions(v *types.Dimensions) error {
+	invalidParams := smithy.InvalidParamsError{Context: "Dimensions"}
+	if v.Height == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Height"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func validateOpCreateWidgetInput(v *CreateWidgetInput) error {
+	invalidParams := smithy.InvalidParamsError{Context: "CreateWidgetInput"}
+	if v.Name == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Name"))
+	}
+	if v.Dimensions == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Dimensions"))
+	} else if v.Dimensions != nil {
+		if err := validateDimensions(v.Dimensions); err != nil {
+			invalidParams.AddNested("Dimensions", err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
@@ -53,6 +53,7 @@ func main() {
 			g: g,
 
 			frameworkDataSources: make([]ResourceDatum, 0),
+			frameworkFunctions:   make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
@@ -71,6 +72,7 @@ func main() {
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
 			FrameworkDataSources: v.frameworkDataSources,
+			FrameworkFunctions:   v.frameworkFunctions,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
 			SDKResources:         v.sdkResources,
@@ -84,6 +86,9 @@ func main() {
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
+		sort.SliceStable(s.FrameworkFunctions, func(i, j int) bool {
+			return s.FrameworkFunctions[i].FactoryName < s.FrameworkFunctions[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkResources, func(i, j int) bool {
 			return s.FrameworkResources[i].FactoryName < s.FrameworkResources[j].FactoryName
 		})
@@ -119,6 +124,7 @@ type ServiceDatum struct {
 	ProviderPackage      string
 	ProviderNameUpper    string
 	FrameworkDataSources []ResourceDatum
+	FrameworkFunctions   []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
@@ -141,6 +147,7 @@ type visitor struct {
 	packageName  string
 
 	frameworkDataSources []ResourceDatum
+	frameworkFunctions   []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
@@ -181,7 +188,8 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// or a Plugin Framework provider function.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
@@ -229,6 +237,17 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
+			case "Function":
+				if d.TransparentTagging {
+					v.err = multierror.Append(v.err, fmt.Errorf("Tags annotation not supported on Function: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if slices.ContainsFunc(v.frameworkFunctions, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Function: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.frameworkFunctions = append(v.frameworkFunctions, d)
+				}
 			case "FrameworkResource":
 				if slices.ContainsFunc(v.frameworkResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func newTestVisitor() *visitor {
+	return &visitor{
+		g: common.NewGenerator(),
+
+		frameworkDataSources: make([]ResourceDatum, 0),
+		frameworkFunctions:   make([]ResourceDatum, 0),
+		frameworkResources:   make([]ResourceDatum, 0),
+		sdkDataSources:       make(map[string]ResourceDatum),
+		sdkResources:         make(map[string]ResourceDatum),
+	}
+}
+
+func processTestSource(t *testing.T, sources ...string) *visitor {
+	t.Helper()
+
+	dir := t.TempDir()
+	for i, source := range sources {
+		filename := filepath.Join(dir, string(rune('a'+i))+".go")
+		if err := os.WriteFile(filename, []byte(source), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	v := newTestVisitor()
+	v.processDir(dir)
+
+	return v
+}
+
+func TestProcessDirFunction(t *testing.T) {
+	t.Parallel()
+
+	v := processTestSource(t, `package example
+
+// @Function(name="Example")
+func newExampleFunction() function.Function {
+	return &exampleFunction{}
+}
+
+// @FrameworkResource(name="Thing")
+func newThingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &thingResource{}, nil
+}
+`)
+
+	if err := v.err.ErrorOrNil(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(v.frameworkFunctions), 1; got != want {
+		t.Fatalf("length of frameworkFunctions = %v, want %v", got, want)
+	}
+	if got, want := v.frameworkFunctions[0].FactoryName, "newExampleFunction"; got != want {
+		t.Errorf("FactoryName = %v, want %v", got, want)
+	}
+	if got, want := v.frameworkFunctions[0].Name, "Example"; got != want {
+		t.Errorf("Name = %v, want %v", got, want)
+	}
+	if got, want := len(v.frameworkResources), 1; got != want {
+		t.Errorf("length of frameworkResources = %v, want %v", got, want)
+	}
+}
+
+func TestProcessDirFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sources       []string
+		expectedError string
+	}{
+		"duplicate": {
+			sources: []string{
+				"package example\n\n// @Function\nfunc newExampleFunction() function.Function {\n\treturn nil\n}\n",
+				"package example\n\n// @Function\nfunc newExampleFunction() function.Function {\n\treturn nil\n}\n",
+			},
+			expectedError: "duplicate Function: example.newExampleFunction",
+		},
+		"tags": {
+			sources: []string{
+				"package example\n\n// @Function\n// @Tags\nfunc newExampleFunction() function.Function {\n\treturn nil\n}\n",
+			},
+			expectedError: "Tags annotation not supported on Function: example.newExampleFunction",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := processTestSource(t, testCase.sources...)
+
+			err := v.err.ErrorOrNil()
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("got error %v, expected %s", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestTemplateFunctions(t *testing.T) {
+	t.Parallel()
+
+	s := ServiceDatum{
+		SkipClientGenerate: true,
+		ProviderPackage:    "example",
+		ProviderNameUpper:  "Example",
+		FrameworkFunctions: []ResourceDatum{
+			{FactoryName: "newExampleFunction", Name: "Example"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := template.Must(template.New("servicepackagedata").Parse(tmpl)).Execute(&buf, s); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("formatting generated source: %s", err)
+	}
+
+	want := `func (p *servicePackage) FrameworkFunctions(ctx context.Context) []*types.ServicePackageFrameworkFunction {
+	return []*types.ServicePackageFrameworkFunction{
+		{
+			Factory: newExampleFunction,
+			Name:    "Example",
+		},
+	}
+}`
+	if got := string(body); !strings.Contains(got, want) {
+		t.Errorf("generated source does not contain FrameworkFunctions:\n%s", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	v1 "github.com/hashicorp/terraform-provider-aws/internal/generate/tags/templates/v1"
 	v2 "github.com/hashicorp/terraform-provider-aws/internal/generate/tags/templates/v2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -37,6 +39,7 @@ var (
 	listTags                 = flag.Bool("ListTags", false, "whether to generate ListTags")
 	serviceTagsMap           = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
 	serviceTagsSlice         = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
+	tagKeysCaseInsensitive   = flag.Bool("TagKeysCaseInsensitive", false, "whether tag keys that differ only in case are the same key")
 	untagInNeedTagType       = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
 	updateTags               = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
 	updateTagsNoIgnoreSystem = flag.Bool("UpdateTagsNoIgnoreSystem", false, "whether to not ignore system tags in UpdateTags")
@@ -55,14 +58,19 @@ var (
 	listTagsOpPaginated        = flag.Bool("ListTagsOpPaginated", false, "whether ListTagsOp is paginated")
 	listTagsOutTagsElem        = flag.String("ListTagsOutTagsElem", "Tags", "listTagsOutTagsElem")
 	setTagsOutFunc             = flag.String("SetTagsOutFunc", "setTagsOut", "setTagsOutFunc")
+	tagAllowedChars            = flag.String("TagAllowedChars", "", "regular expression character class of characters allowed in tag keys and values")
 	tagInCustomVal             = flag.String("TagInCustomVal", "", "tagInCustomVal")
 	tagInIDElem                = flag.String("TagInIDElem", "ResourceArn", "tagInIDElem")
 	tagInIDNeedSlice           = flag.String("TagInIDNeedSlice", "", "tagInIDNeedSlice")
 	tagInIDNeedValueSlice      = flag.String("TagInIDNeedValueSlice", "", "tagInIDNeedValueSlice")
 	tagInTagsElem              = flag.String("TagInTagsElem", "Tags", "tagInTagsElem")
 	tagKeyType                 = flag.String("TagKeyType", "", "tagKeyType")
+	tagMaxCount                = flag.Int("TagMaxCount", 0, "maximum number of tags per resource")
+	tagMaxKeyLength            = flag.Int("TagMaxKeyLength", 0, "maximum length of a tag key")
+	tagMaxValueLength          = flag.Int("TagMaxValueLength", 0, "maximum length of a tag value")
 	tagOp                      = flag.String("TagOp", "TagResource", "tagOp")
 	tagOpBatchSize             = flag.String("TagOpBatchSize", "", "tagOpBatchSize")
+	tagReservedKeyPrefix       = flag.String("TagReservedKeyPrefix", "", "tag key prefix reserved for use by AWS")
 	tagResTypeElem             = flag.String("TagResTypeElem", "", "tagResTypeElem")
 	tagType                    = flag.String("TagType", "Tag", "tagType")
 	tagType2                   = flag.String("TagType2", "", "tagType")
@@ -108,6 +116,7 @@ type TemplateBody struct {
 	listTags           string
 	serviceTagsMap     string
 	serviceTagsSlice   string
+	tagConstraints     string
 	updateTags         string
 	waitTagsPropagated string
 }
@@ -121,6 +130,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			listTags:           "\n" + v1.ListTagsBody,
 			serviceTagsMap:     "\n" + v1.ServiceTagsMapBody,
 			serviceTagsSlice:   "\n" + v1.ServiceTagsSliceBody,
+			tagConstraints:     "\n" + v1.TagConstraintsBody,
 			updateTags:         "\n" + v1.UpdateTagsBody,
 			waitTagsPropagated: "\n" + v1.WaitTagsPropagatedBody,
 		}
@@ -132,6 +142,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 				listTags:           "\n" + v2.ListTagsBody,
 				serviceTagsMap:     "\n" + v2.ServiceTagsValueMapBody,
 				serviceTagsSlice:   "\n" + v2.ServiceTagsSliceBody,
+				tagConstraints:     "\n" + v2.TagConstraintsBody,
 				updateTags:         "\n" + v2.UpdateTagsBody,
 				waitTagsPropagated: "\n" + v2.WaitTagsPropagatedBody,
 			}
@@ -142,6 +153,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			listTags:           "\n" + v2.ListTagsBody,
 			serviceTagsMap:     "\n" + v2.ServiceTagsMapBody,
 			serviceTagsSlice:   "\n" + v2.ServiceTagsSliceBody,
+			tagConstraints:     "\n" + v2.TagConstraintsBody,
 			updateTags:         "\n" + v2.UpdateTagsBody,
 			waitTagsPropagated: "\n" + v2.WaitTagsPropagatedBody,
 		}
@@ -174,15 +186,22 @@ type TemplateData struct {
 	RetryCreateOnNotFound      string
 	ServiceTagsMap             bool
 	SetTagsOutFunc             string
+	TagAllowedChars            string
+	TagAllowedCharsDefault     bool
 	TagInCustomVal             string
 	TagInIDElem                string
 	TagInIDNeedSlice           string
 	TagInIDNeedValueSlice      string
 	TagInTagsElem              string
 	TagKeyType                 string
+	TagKeysCaseInsensitive     bool
+	TagMaxCount                int
+	TagMaxKeyLength            int
+	TagMaxValueLength          int
 	TagOp                      string
 	TagOpBatchSize             string
 	TagPackage                 string
+	TagReservedKeyPrefix       string
 	TagResTypeElem             string
 	TagType                    string
 	TagType2                   string
@@ -331,15 +350,22 @@ func main() {
 		ParentNotFoundErrMsg:       *parentNotFoundErrMsg,
 		ServiceTagsMap:             *serviceTagsMap,
 		SetTagsOutFunc:             *setTagsOutFunc,
+		TagAllowedChars:            *tagAllowedChars,
+		TagAllowedCharsDefault:     *tagAllowedChars == tftags.AllowedCharactersDefault,
 		TagInCustomVal:             *tagInCustomVal,
 		TagInIDElem:                *tagInIDElem,
 		TagInIDNeedSlice:           *tagInIDNeedSlice,
 		TagInIDNeedValueSlice:      *tagInIDNeedValueSlice,
 		TagInTagsElem:              *tagInTagsElem,
 		TagKeyType:                 *tagKeyType,
+		TagKeysCaseInsensitive:     *tagKeysCaseInsensitive,
+		TagMaxCount:                *tagMaxCount,
+		TagMaxKeyLength:            *tagMaxKeyLength,
+		TagMaxValueLength:          *tagMaxValueLength,
 		TagOp:                      *tagOp,
 		TagOpBatchSize:             *tagOpBatchSize,
 		TagPackage:                 tagPackage,
+		TagReservedKeyPrefix:       *tagReservedKeyPrefix,
 		TagResTypeElem:             *tagResTypeElem,
 		TagType:                    *tagType,
 		TagType2:                   *tagType2,
@@ -368,10 +394,23 @@ func main() {
 		IsDefaultUpdateTags: *updateTagsFunc == defaultUpdateTagsFunc,
 	}
 
+	if v := *tagAllowedChars; v != "" {
+		re, err := regexp.Compile(fmt.Sprintf(`^[%s]*$`, v))
+		if err != nil {
+			g.Fatalf("TagAllowedChars (%s): %s", v, err)
+		}
+		// Catch a character class whose backslashes have been removed, e.g. by a shell.
+		if !re.MatchString("Name") {
+			g.Fatalf("TagAllowedChars (%s) does not allow letters", v)
+		}
+	}
+
+	tagConstraints := *tagAllowedChars != "" || *tagKeysCaseInsensitive || *tagMaxCount > 0 || *tagMaxKeyLength > 0 || *tagMaxValueLength > 0 || *tagReservedKeyPrefix != ""
+
 	templateBody := newTemplateBody(*sdkVersion, *kvtValues)
 	d := g.NewGoFileDestination(filename)
 
-	if *getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags {
+	if *getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags || tagConstraints {
 		// If you intend to only generate Tags and KeyValueTags helper methods,
 		// the corresponding aws-sdk-go	service package does not need to be imported
 		if !*getTag && !*listTags && !*serviceTagsSlice && !*updateTags {
@@ -414,6 +453,12 @@ func main() {
 		}
 	}
 
+	if tagConstraints {
+		if err := d.WriteTemplate("tagconstraints", templateBody.tagConstraints, templateData); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
 	if *waitForPropagation {
 		if err := d.WriteTemplate("waittagspropagated", templateBody.waitTagsPropagated, templateData); err != nil {
 			g.Fatalf("generating file (%s): %s", filename, err)
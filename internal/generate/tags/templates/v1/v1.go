@@ -22,6 +22,9 @@ var ServiceTagsMapBody string
 //go:embed service_tags_slice_body.tmpl
 var ServiceTagsSliceBody string
 
+//go:embed tag_constraints_body.tmpl
+var TagConstraintsBody string
+
 //go:embed update_tags_body.tmpl
 var UpdateTagsBody string
 
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+var (
+	// documentElementOrder is the order of known policy document elements in canonical form.
+	// Version must be first to pass IAM's legacy policy parsing.
+	documentElementOrder = []string{"Version", "Id", "Statement"}
+	// statementElementOrder is the order of known statement elements in canonical form.
+	statementElementOrder = []string{"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource", "NotResource", "Condition"}
+)
+
+// Canonicalize returns the canonical form of an IAM policy document.
+// In canonical form the document is compact JSON, the statements are a list sorted by their content,
+// single-element lists of actions, resources, principals and condition values are collapsed to a single value,
+// multi-element lists of strings are sorted, and elements are in a fixed order with Version first.
+// Policy documents that are equivalent as determined by the awspolicyequivalence package
+// have the same canonical form, except where principals are specified as both an account ID and its root user ARN.
+// An empty policy document is returned as is.
+func Canonicalize(policy string) (string, error) {
+	if strings.TrimSpace(policy) == "" {
+		return "", nil
+	}
+
+	var doc map[string]any
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&doc); err != nil {
+		return "", err
+	}
+
+	if v, ok := doc["Statement"]; ok {
+		statements, err := canonicalStatements(v)
+		if err != nil {
+			return "", err
+		}
+
+		doc["Statement"] = statements
+	}
+
+	b, err := json.Marshal(orderedObject{elements: doc, order: documentElementOrder})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// canonicalStatements returns the canonical form of a policy document's Statement element.
+func canonicalStatements(v any) (any, error) {
+	var statements []any
+
+	switch v := v.(type) {
+	case map[string]any:
+		statements = []any{v}
+	case []any:
+		statements = v
+	default:
+		return v, nil
+	}
+
+	type sortableStatement struct {
+		key       string
+		statement any
+	}
+	sortable := make([]sortableStatement, 0, len(statements))
+
+	for _, statement := range statements {
+		if v, ok := statement.(map[string]any); ok {
+			statement = canonicalStatement(v)
+		}
+
+		b, err := json.Marshal(statement)
+		if err != nil {
+			return nil, err
+		}
+
+		sortable = append(sortable, sortableStatement{key: string(b), statement: statement})
+	}
+
+	slices.SortStableFunc(sortable, func(a, b sortableStatement) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	statements = make([]any, 0, len(sortable))
+	for _, v := range sortable {
+		statements = append(statements, v.statement)
+	}
+
+	return statements, nil
+}
+
+// canonicalStatement returns the canonical form of a single policy statement.
+func canonicalStatement(statement map[string]any) orderedObject {
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalValues(v)
+		}
+	}
+
+	// Principals are either the string "*" or a map of principal type to identifiers.
+	// "*" is not equivalent to {"AWS": "*"} for all services, so only the identifiers are changed.
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := statement[k].(map[string]any); ok {
+			for typ, identifiers := range v {
+				v[typ] = canonicalValues(identifiers)
+			}
+		}
+	}
+
+	if v, ok := statement["Condition"].(map[string]any); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				for key, values := range v {
+					v[key] = canonicalValues(values)
+				}
+			}
+		}
+	}
+
+	return orderedObject{elements: statement, order: statementElementOrder}
+}
+
+// canonicalValues returns the canonical form of a value that may be specified as a single value or a list.
+// Single-element lists are collapsed and lists of strings are sorted.
+func canonicalValues(v any) any {
+	values, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return values
+		}
+		strs = append(strs, s)
+	}
+	slices.Sort(strs)
+
+	values = make([]any, 0, len(strs))
+	for _, s := range strs {
+		values = append(values, s)
+	}
+
+	return values
+}
+
+// orderedObject is a JSON object whose elements are marshaled in the specified order,
+// followed by any other elements in lexical order.
+type orderedObject struct {
+	elements map[string]any
+	order    []string
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	keys := make([]string, 0, len(o.elements))
+	for _, k := range o.order {
+		if _, ok := o.elements[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+
+	var others []string
+	for k := range o.elements {
+		if !slices.Contains(o.order, k) {
+			others = append(others, k)
+		}
+	}
+	slices.Sort(others)
+	keys = append(keys, others...)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		b, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+		buf.WriteByte(':')
+
+		if b, err = json.Marshal(o.elements[k]); err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		"empty": {
+			policy: "  ",
+			want:   "",
+		},
+		"empty object": {
+			policy: `{}`,
+			want:   `{}`,
+		},
+		"element order": {
+			policy: `{
+  "Statement": [{"Resource": "*", "Action": "s3:GetObject", "Effect": "Allow", "Sid": "One"}],
+  "Id": "example",
+  "Version": "2012-10-17"
+}`,
+			want: `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"unknown elements": {
+			policy: `{"Zed": "last", "Version": "2012-10-17", "Statement": [{"Zed": "last", "Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Zed":"last"}],"Zed":"last"}`,
+		},
+		"single statement object": {
+			policy: `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		"statements sorted": {
+			policy: `{"Version": "2012-10-17", "Statement": [
+  {"Sid": "B", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"},
+  {"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}
+]}`,
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"lists collapsed and sorted": {
+			policy: `{"Version": "2012-10-17", "Statement": [{
+  "Effect": "Allow",
+  "Action": ["s3:PutObject", "s3:GetObject"],
+  "NotResource": ["arn:aws:s3:::bucket/private/*"],
+  "Condition": {"StringEquals": {"aws:PrincipalTag/team": ["b", "a"], "aws:username": ["bob"]}, "Bool": {"aws:SecureTransport": [true]}}
+}]}`,
+			want: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"NotResource":"arn:aws:s3:::bucket/private/*","Condition":{"Bool":{"aws:SecureTransport":true},"StringEquals":{"aws:PrincipalTag/team":["a","b"],"aws:username":"bob"}}}]}`,
+		},
+		"principals": {
+			policy: `{"Version": "2012-10-17", "Statement": [{
+  "Effect": "Allow",
+  "Principal": {"Service": ["s3.amazonaws.com"], "AWS": ["arn:aws:iam::123456789012:root", "arn:aws:iam::111122223333:root"]},
+  "Action": "sts:AssumeRole"
+}, {
+  "Effect": "Deny",
+  "Principal": "*",
+  "Action": "sts:AssumeRole"
+}]}`,
+			want: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:root","arn:aws:iam::123456789012:root"],"Service":"s3.amazonaws.com"},"Action":"sts:AssumeRole"},{"Effect":"Deny","Principal":"*","Action":"sts:AssumeRole"}]}`,
+		},
+		"numbers preserved": {
+			policy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"NumericLessThan": {"s3:max-keys": [10.50]}}}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericLessThan":{"s3:max-keys":10.50}}}]}`,
+		},
+		"invalid JSON": {
+			policy:  `{"Version": }`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Canonicalize(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Canonicalize() err %t, want %t", got, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got != testCase.want {
+				t.Errorf("Canonicalize() = %s, want %s", got, testCase.want)
+			}
+
+			if again, err := Canonicalize(got); err != nil || again != got {
+				t.Errorf("Canonicalize() is not idempotent: %s", again)
+			}
+
+			if testCase.policy != "" {
+				if equivalent, err := awspolicy.PoliciesAreEquivalent(testCase.policy, got); err != nil || !equivalent {
+					t.Errorf("Canonicalize() = %s, not equivalent to %s", got, testCase.policy)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"strings"
+	"sync"
+)
+
+//go:embed data/service_prefixes.csv
+var servicePrefixesData []byte
+
+//go:embed data/condition_operators.csv
+var conditionOperatorsData []byte
+
+// catalog is the embedded catalogue of IAM service action prefixes and condition operators.
+type catalog struct {
+	// conditionOperators maps condition operator name to whether the operator supports the "IfExists" suffix.
+	conditionOperators map[string]bool
+	// servicePrefixes maps service action prefix, e.g. "s3", to the service namespaces of the
+	// resource ARNs that the service's actions apply to. Nil if resource ARNs are not checked.
+	servicePrefixes map[string][]string
+}
+
+var loadCatalog = sync.OnceValue(func() *catalog {
+	c := &catalog{
+		conditionOperators: make(map[string]bool),
+		servicePrefixes:    make(map[string][]string),
+	}
+
+	for _, record := range mustReadCSV(conditionOperatorsData) {
+		c.conditionOperators[record[0]] = record[1] != ""
+	}
+
+	for _, record := range mustReadCSV(servicePrefixesData) {
+		var arnServices []string
+		if record[1] != "" {
+			arnServices = strings.Split(record[1], ";")
+		}
+		c.servicePrefixes[record[0]] = arnServices
+	}
+
+	return c
+})
+
+// mustReadCSV returns the records, excluding the header, of the embedded CSV data.
+func mustReadCSV(data []byte) [][]string {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return records[1:]
+}
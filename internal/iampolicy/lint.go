@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Lint returns warnings about likely mistakes in an IAM policy document, such as
+// unknown service action prefixes, unknown condition operators, resource ARNs for
+// a different service than the statement's actions, and NotPrincipal used with Allow.
+// An error is returned only if the policy document cannot be parsed.
+func Lint(policy string) ([]string, error) {
+	var doc lintDocument
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	c := loadCatalog()
+	var warnings []string
+
+	for i, statement := range doc.Statements {
+		name := fmt.Sprintf("statement %d", i)
+		if statement.Sid != "" {
+			name = fmt.Sprintf("statement %d (%s)", i, statement.Sid)
+		}
+
+		for _, warning := range statement.lint(c) {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", name, warning))
+		}
+	}
+
+	return warnings, nil
+}
+
+type lintDocument struct {
+	Statements lintStatements `json:"Statement"`
+}
+
+type lintStatement struct {
+	Action       lintStrings                           `json:"Action"`
+	Condition    map[string]map[string]json.RawMessage `json:"Condition"`
+	Effect       string                                `json:"Effect"`
+	NotAction    lintStrings                           `json:"NotAction"`
+	NotPrincipal json.RawMessage                       `json:"NotPrincipal"`
+	NotResource  lintStrings                           `json:"NotResource"`
+	Resource     lintStrings                           `json:"Resource"`
+	Sid          string                                `json:"Sid"`
+}
+
+func (s lintStatement) lint(c *catalog) []string {
+	var warnings []string
+
+	if s.Effect != "Allow" && s.Effect != "Deny" {
+		warnings = append(warnings, fmt.Sprintf("Effect (%s) is not Allow or Deny", s.Effect))
+	}
+
+	if s.Effect == "Allow" && len(s.NotPrincipal) > 0 {
+		warnings = append(warnings, "NotPrincipal with Effect Allow grants access to all principals except those listed, including anonymous users")
+	}
+
+	// Resource ARNs are checked against the service namespaces of the statement's actions
+	// only if all of the actions' namespaces are known.
+	var arnServices []string
+	checkResources := len(s.Action) > 0 && len(s.NotAction) == 0
+
+	for _, action := range append(slices.Clone(s.Action), s.NotAction...) {
+		if action == "*" {
+			checkResources = false
+			continue
+		}
+
+		prefix, name, ok := strings.Cut(action, ":")
+		if !ok || !regexache.MustCompile(`^[a-z0-9-]+$`).MatchString(prefix) || !regexache.MustCompile(`^[A-Za-z0-9*?-]+$`).MatchString(name) {
+			warnings = append(warnings, fmt.Sprintf("action (%s) is not of the form service:action", action))
+			checkResources = false
+			continue
+		}
+
+		v, ok := c.servicePrefixes[prefix]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("action (%s) has unknown service prefix (%s)", action, prefix))
+		}
+		if v == nil {
+			checkResources = false
+		}
+		arnServices = append(arnServices, v...)
+	}
+
+	if checkResources {
+		for _, resource := range append(slices.Clone(s.Resource), s.NotResource...) {
+			const arnComponents = 6
+			parts := strings.SplitN(resource, ":", arnComponents)
+			if len(parts) < arnComponents || parts[0] != "arn" {
+				continue
+			}
+
+			service := parts[2]
+			if service == "" || strings.ContainsAny(service, "*?$") {
+				continue
+			}
+
+			if !slices.Contains(arnServices, service) {
+				warnings = append(warnings, fmt.Sprintf("resource (%s) is for service (%s), which does not match the service of any action", resource, service))
+			}
+		}
+	}
+
+	operators := make([]string, 0, len(s.Condition))
+	for operator := range s.Condition {
+		operators = append(operators, operator)
+	}
+	slices.Sort(operators)
+
+	for _, operator := range operators {
+		if !c.validConditionOperator(operator) {
+			warnings = append(warnings, fmt.Sprintf("unknown condition operator (%s)", operator))
+		}
+	}
+
+	return warnings
+}
+
+func (c *catalog) validConditionOperator(operator string) bool {
+	if v, ok := strings.CutPrefix(operator, "ForAllValues:"); ok {
+		operator = v
+	} else if v, ok := strings.CutPrefix(operator, "ForAnyValue:"); ok {
+		operator = v
+	}
+
+	if _, ok := c.conditionOperators[operator]; ok {
+		return true
+	}
+
+	if v, ok := strings.CutSuffix(operator, "IfExists"); ok {
+		return c.conditionOperators[v]
+	}
+
+	return false
+}
+
+// lintStatements is a list of statements that may also be specified as a single statement object.
+type lintStatements []lintStatement
+
+func (s *lintStatements) UnmarshalJSON(b []byte) error {
+	if b := bytes.TrimSpace(b); len(b) > 0 && b[0] == '{' {
+		var statement lintStatement
+		if err := json.Unmarshal(b, &statement); err != nil {
+			return err
+		}
+
+		*s = lintStatements{statement}
+		return nil
+	}
+
+	var statements []lintStatement
+	if err := json.Unmarshal(b, &statements); err != nil {
+		return err
+	}
+
+	*s = statements
+	return nil
+}
+
+// lintStrings is a list of strings that may also be specified as a single string.
+type lintStrings []string
+
+func (s *lintStrings) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case string:
+		*s = lintStrings{v}
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				*s = append(*s, v)
+			}
+		}
+	}
+
+	return nil
+}
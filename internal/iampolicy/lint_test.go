@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy       string
+		wantWarnings []string
+		wantErr      bool
+	}{
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*"],
+      "Resource": ["arn:aws:s3:::bucket", "arn:aws:s3:::bucket/*"],
+      "Condition": {"StringLikeIfExists": {"s3:prefix": "home/*"}, "ForAnyValue:StringEquals": {"aws:TagKeys": "a"}}
+    },
+    {
+      "Effect": "Allow",
+      "Action": "sts:AssumeRole",
+      "Resource": "arn:aws:iam::123456789012:role/example"
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "Resource": "*",
+      "Condition": {"Null": {"aws:MultiFactorAuthAge": "true"}}
+    }
+  ]
+}`,
+		},
+		"single statement": {
+			policy: `{"Statement": {"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}}`,
+		},
+		"invalid effect": {
+			policy: `{"Statement": [{"Effect": "allow", "Action": "ec2:DescribeInstances", "Resource": "*"}]}`,
+			wantWarnings: []string{
+				"statement 0: Effect (allow) is not Allow or Deny",
+			},
+		},
+		"NotPrincipal with Allow": {
+			policy: `{"Statement": [{"Sid": "Everyone", "Effect": "Allow", "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "s3:GetObject", "Resource": "*"}]}`,
+			wantWarnings: []string{
+				"statement 0 (Everyone): NotPrincipal with Effect Allow grants access to all principals except those listed, including anonymous users",
+			},
+		},
+		"malformed action": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": ["s3GetObject", "s3:Get Object"], "Resource": "*"}]}`,
+			wantWarnings: []string{
+				"statement 0: action (s3GetObject) is not of the form service:action",
+				"statement 0: action (s3:Get Object) is not of the form service:action",
+			},
+		},
+		"unknown service prefix": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "ec3:DescribeInstances", "Resource": "*"}]}`,
+			wantWarnings: []string{
+				"statement 0: action (ec3:DescribeInstances) has unknown service prefix (ec3)",
+			},
+		},
+		"resource for other service": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["arn:aws:s3:::bucket/*", "arn:aws:sqs:us-west-2:123456789012:queue"]}]}`, // lintignore:AWSAT003,AWSAT005
+			wantWarnings: []string{
+				"statement 0: resource (arn:aws:sqs:us-west-2:123456789012:queue) is for service (sqs), which does not match the service of any action", // lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"resource not checked for wildcard action": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "arn:aws:sqs:us-west-2:123456789012:queue"}]}`, // lintignore:AWSAT003,AWSAT005
+		},
+		"unknown condition operators": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"StringEqual": {"aws:username": "bob"}, "NullIfExists": {"aws:username": "true"}, "ForAllValues:Bogus": {"aws:TagKeys": "a"}}}]}`,
+			wantWarnings: []string{
+				"statement 0: unknown condition operator (ForAllValues:Bogus)",
+				"statement 0: unknown condition operator (NullIfExists)",
+				"statement 0: unknown condition operator (StringEqual)",
+			},
+		},
+		"invalid JSON": {
+			policy:  `{"Statement": [}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Lint(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Lint() err %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.wantWarnings); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/json/ujson"
+)
+
+// MaskPaths replaces the values at the specified paths in a valid JSON string with the string mask.
+// Paths are JSON pointers (RFC 6901) in which the reference token "*" matches any object key or array index,
+// e.g. "/environment/*/value". null values are not masked.
+func MaskPaths(in, mask string, paths ...string) string {
+	patterns := parsePointerPatterns(paths...)
+	maskValue, err := json.Marshal(mask)
+
+	if err != nil {
+		return ""
+	}
+
+	out := make([]byte, 0, len(in))
+
+	err = WalkPointers([]byte(in), func(tokens []string, key, value []byte) bool {
+		masked := value[0] != '}' && value[0] != ']' && value[0] != 'n' && matchesAny(patterns, tokens)
+
+		// Write to output.
+		if len(out) != 0 && ujson.ShouldAddComma(value, out[len(out)-1]) {
+			out = append(out, ',')
+		}
+		if len(key) > 0 {
+			out = append(out, key...)
+			out = append(out, ':')
+		}
+
+		if masked {
+			out = append(out, maskValue...)
+			// Skip any object or array members.
+			return false
+		}
+
+		out = append(out, value...)
+
+		return true
+	})
+
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
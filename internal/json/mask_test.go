@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"testing"
+)
+
+func TestMaskPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		input    string
+		paths    []string
+		want     string
+	}{
+		{
+			testName: "empty JSON",
+			input:    "{}",
+			paths:    []string{"/environment/*/value"},
+			want:     "{}",
+		},
+		{
+			testName: "wildcard array element field",
+			input:    `{"value": "keep", "environment": [{"name": "A", "value": "secret"}, {"name": "B", "value": 42}]}`,
+			paths:    []string{"/environment/*/value"},
+			want:     `{"value":"keep","environment":[{"name":"A","value":"***"},{"name":"B","value":"***"}]}`,
+		},
+		{
+			testName: "object and array values",
+			input:    `{"secrets": {"a": "x", "b": ["y"]}, "list": [1, 2]}`,
+			paths:    []string{"/secrets", "/list"},
+			want:     `{"secrets":"***","list":"***"}`,
+		},
+		{
+			testName: "null not masked",
+			input:    `{"password": null}`,
+			paths:    []string{"/password"},
+			want:     `{"password":null}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := MaskPaths(testCase.input, "***", testCase.paths...), testCase.want; got != want {
+				t.Errorf("MaskPaths(%q, %q) = %q, want %q", testCase.input, testCase.paths, got, want)
+			}
+		})
+	}
+}
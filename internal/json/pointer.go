@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/json/ujson"
+)
+
+// WalkPointers is like ujson.Walk, but the callback is passed the JSON pointer (RFC 6901) reference tokens
+// of each value instead of its level. For the closing bracket of an object or array the reference tokens are
+// those of the object or array. Array elements' reference tokens are their indexes.
+// The callback must not retain or modify the reference tokens slice.
+func WalkPointers(in []byte, callback func(tokens []string, key, value []byte) bool) error {
+	var (
+		tokens     []string // Reference tokens of the current value.
+		containers []byte   // Open bracket of each enclosing object or array, by level.
+		children   []int    // Number of children of each enclosing object or array, by level.
+		keyErr     error
+	)
+
+	err := ujson.Walk(in, func(level int, key, value []byte) bool {
+		switch value[0] {
+		case '}', ']':
+			containers, children = containers[:level], children[:level]
+			return callback(tokens[:level], key, value)
+		}
+
+		if level > 0 {
+			var token string
+			if containers[level-1] == '{' {
+				k, err := ujson.Unquote(key)
+				if err != nil && keyErr == nil {
+					keyErr = err
+				}
+				token = string(k)
+			} else {
+				token = strconv.Itoa(children[level-1])
+			}
+			children[level-1]++
+			tokens = append(tokens[:level-1], token)
+		}
+
+		switch value[0] {
+		case '{', '[':
+			containers = append(containers[:level], value[0])
+			children = append(children[:level], 0)
+		}
+
+		return callback(tokens[:level], key, value)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return keyErr
+}
+
+// Pointer returns the JSON pointer (RFC 6901) for the specified reference tokens.
+func Pointer(tokens []string) string {
+	var sb strings.Builder
+
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+
+	return sb.String()
+}
+
+// pointerPattern is a JSON pointer in which the reference token "*" matches any object key or array index.
+type pointerPattern []string
+
+// parsePointerPatterns parses JSON pointer patterns, e.g. "/Statement/*/Sid".
+// Patterns that are not JSON pointers to a value within the document, i.e. that don't start with "/", are ignored.
+func parsePointerPatterns(patterns ...string) []pointerPattern {
+	var pointerPatterns []pointerPattern
+
+	for _, pattern := range patterns {
+		v, ok := strings.CutPrefix(pattern, "/")
+		if !ok {
+			continue
+		}
+
+		var tokens pointerPattern
+		for _, token := range strings.Split(v, "/") {
+			tokens = append(tokens, strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~"))
+		}
+
+		pointerPatterns = append(pointerPatterns, tokens)
+	}
+
+	return pointerPatterns
+}
+
+// matchesAny returns whether the reference tokens match any of the patterns.
+func matchesAny(patterns []pointerPattern, tokens []string) bool {
+	for _, pattern := range patterns {
+		if pattern.matches(tokens) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p pointerPattern) matches(tokens []string) bool {
+	if len(p) != len(tokens) {
+		return false
+	}
+
+	for i, token := range p {
+		if token != "*" && token != tokens[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWalkPointers(t *testing.T) {
+	t.Parallel()
+
+	input := `{"a": [1, {"b/c": true}], "d~": {}, "e": null}`
+	var got []string
+
+	err := WalkPointers([]byte(input), func(tokens []string, _, value []byte) bool {
+		got = append(got, Pointer(tokens)+" "+string(value))
+		return true
+	})
+
+	if err != nil {
+		t.Fatalf("WalkPointers() err = %s", err)
+	}
+
+	want := []string{
+		" {",
+		"/a [",
+		"/a/0 1",
+		"/a/1 {",
+		"/a/1/b~1c true",
+		"/a/1 }",
+		"/a ]",
+		"/d~0 {",
+		"/d~0 }",
+		"/e null",
+		" }",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -44,6 +44,39 @@ func RemoveFields(in string, fields ...string) string {
 	return string(out)
 }
 
+// RemovePaths removes the values at the specified paths from a valid JSON string.
+// Paths are JSON pointers (RFC 6901) in which the reference token "*" matches any object key or array index,
+// e.g. "/Statement/*/Sid". Removing an array element does not change the indexes of the elements that follow it.
+func RemovePaths(in string, paths ...string) string {
+	patterns := parsePointerPatterns(paths...)
+	out := make([]byte, 0, len(in))
+
+	err := WalkPointers([]byte(in), func(tokens []string, key, value []byte) bool {
+		if value[0] != '}' && value[0] != ']' && matchesAny(patterns, tokens) {
+			// Remove the key and value from the output.
+			return false
+		}
+
+		// Write to output.
+		if len(out) != 0 && ujson.ShouldAddComma(value, out[len(out)-1]) {
+			out = append(out, ',')
+		}
+		if len(key) > 0 {
+			out = append(out, key...)
+			out = append(out, ':')
+		}
+		out = append(out, value...)
+
+		return true
+	})
+
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
+
 // RemoveEmptyFields removes all empty fields from a valid JSON string.
 func RemoveEmptyFields(in []byte) []byte {
 	n := 0
@@ -44,6 +44,71 @@ func TestRemoveFields(t *testing.T) {
 	}
 }
 
+func TestRemovePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		input    string
+		paths    []string
+		want     string
+	}{
+		{
+			testName: "empty JSON",
+			input:    "{}",
+			paths:    []string{"/Sid"},
+			want:     "{}",
+		},
+		{
+			testName: "top-level field",
+			input:    `{"Sid": "x", "Version": "2012-10-17"}`,
+			paths:    []string{"/Sid"},
+			want:     `{"Version":"2012-10-17"}`,
+		},
+		{
+			testName: "wildcard array element field",
+			input:    `{"Sid": "top", "Statement": [{"Sid": "a", "Effect": "Allow"}, {"Effect": "Deny", "Sid": "b"}]}`,
+			paths:    []string{"/Statement/*/Sid"},
+			want:     `{"Sid":"top","Statement":[{"Effect":"Allow"},{"Effect":"Deny"}]}`,
+		},
+		{
+			testName: "array element",
+			input:    `{"a": [1, 2, 3]}`,
+			paths:    []string{"/a/1"},
+			want:     `{"a":[1,3]}`,
+		},
+		{
+			testName: "object",
+			input:    `{"a": {"b": {"c": 1}}, "d": {"b": 2}}`,
+			paths:    []string{"/a/b"},
+			want:     `{"a":{},"d":{"b":2}}`,
+		},
+		{
+			testName: "escaped key",
+			input:    `{"a/b": 1, "c~d": 2, "e": 3}`,
+			paths:    []string{"/a~1b", "/c~0d"},
+			want:     `{"e":3}`,
+		},
+		{
+			testName: "invalid paths ignored",
+			input:    `{"a": 1}`,
+			paths:    []string{"a", ""},
+			want:     `{"a":1}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := RemovePaths(testCase.input, testCase.paths...), testCase.want; got != want {
+				t.Errorf("RemovePaths(%q, %q) = %q, want %q", testCase.input, testCase.paths, got, want)
+			}
+		})
+	}
+}
+
 func TestRemoveEmptyFields(t *testing.T) {
 	t.Parallel()
 
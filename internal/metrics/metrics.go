@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metrics implements opt-in recording of per-resource, per-operation timings and AWS API call counts.
+// Recording is enabled by setting the TF_AWS_METRICS_FILE environment variable to the path of the JSON summary
+// file that each provider process merges its metrics into when it shuts down.
+package metrics
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// EnvVar is the environment variable that enables metrics recording.
+	// Its value is the path of the JSON summary file.
+	EnvVar = "TF_AWS_METRICS_FILE"
+)
+
+// Enabled returns whether or not metrics recording is enabled.
+func Enabled() bool {
+	return os.Getenv(EnvVar) != ""
+}
+
+// Operation accumulates AWS API call metrics for a single resource or data source operation.
+// It is safe for concurrent use.
+type Operation struct {
+	start     time.Time
+	apiCalls  atomic.Int64
+	apiTime   atomic.Int64 // Nanoseconds.
+	attempts  atomic.Int64
+	throttles atomic.Int64
+}
+
+func newOperation() *Operation {
+	return &Operation{
+		start: time.Now(),
+	}
+}
+
+// addAPICall records the completion of a single AWS API call, including any retries.
+func (op *Operation) addAPICall(d time.Duration) {
+	op.apiCalls.Add(1)
+	op.apiTime.Add(int64(d))
+}
+
+// addAttempts records the number of attempts made for a single AWS API call.
+func (op *Operation) addAttempts(n int64) {
+	op.attempts.Add(n)
+}
+
+// addThrottle records a throttled AWS API call attempt.
+func (op *Operation) addThrottle() {
+	op.throttles.Add(1)
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewContext returns a Context carrying a new Operation.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey, newOperation())
+}
+
+// FromContext returns the Operation stored in Context, if any.
+func FromContext(ctx context.Context) (*Operation, bool) {
+	v, ok := ctx.Value(contextKey).(*Operation)
+	return v, ok
+}
+
+// Summary is the aggregated metrics for a single resource type and operation.
+type Summary struct {
+	TypeName      string `json:"type_name"`
+	DataSource    bool   `json:"data_source"`
+	Operation     string `json:"operation"`
+	Count         int64  `json:"count"`
+	Errors        int64  `json:"errors"`
+	WallTimeMS    int64  `json:"wall_time_ms"`
+	APITimeMS     int64  `json:"api_time_ms"`
+	WaitTimeMS    int64  `json:"wait_time_ms"` // Wall time not spent in AWS API calls, e.g. polling in waiters.
+	APICalls      int64  `json:"api_calls"`
+	Retries       int64  `json:"retries"`
+	Throttles     int64  `json:"throttles"`
+	MaxWallTimeMS int64  `json:"max_wall_time_ms"`
+}
+
+type summaryKey struct {
+	typeName   string
+	dataSource bool
+	operation  string
+}
+
+// Recorder aggregates completed Operations.
+// It is safe for concurrent use.
+type Recorder struct {
+	lock      sync.Mutex
+	summaries map[summaryKey]*Summary
+}
+
+// NewRecorder returns a new, empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		summaries: make(map[summaryKey]*Summary),
+	}
+}
+
+// Record aggregates the specified completed Operation.
+func (r *Recorder) Record(typeName string, dataSource bool, operation string, op *Operation, err error) {
+	wallTime := time.Since(op.start)
+	apiTime := time.Duration(op.apiTime.Load())
+	apiCalls := op.apiCalls.Load()
+	retries := max(op.attempts.Load()-apiCalls, 0)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	k := summaryKey{
+		typeName:   typeName,
+		dataSource: dataSource,
+		operation:  operation,
+	}
+	v, ok := r.summaries[k]
+	if !ok {
+		v = &Summary{
+			TypeName:   typeName,
+			DataSource: dataSource,
+			Operation:  operation,
+		}
+		r.summaries[k] = v
+	}
+
+	v.Count++
+	if err != nil {
+		v.Errors++
+	}
+	v.WallTimeMS += wallTime.Milliseconds()
+	v.APITimeMS += apiTime.Milliseconds()
+	v.WaitTimeMS += max(wallTime-apiTime, 0).Milliseconds()
+	v.APICalls += apiCalls
+	v.Retries += retries
+	v.Throttles += op.throttles.Load()
+	v.MaxWallTimeMS = max(v.MaxWallTimeMS, wallTime.Milliseconds())
+}
+
+// Summaries returns the aggregated metrics, ordered by descending total wall time.
+func (r *Recorder) Summaries() []Summary {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	summaries := make([]Summary, 0, len(r.summaries))
+	for _, v := range r.summaries {
+		summaries = append(summaries, *v)
+	}
+
+	slices.SortFunc(summaries, func(a, b Summary) int {
+		if n := cmp.Compare(b.WallTimeMS, a.WallTimeMS); n != 0 {
+			return n
+		}
+		if n := cmp.Compare(a.TypeName, b.TypeName); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Operation, b.Operation)
+	})
+
+	return summaries
+}
+
+// report is the top-level structure of the JSON summary file.
+type report struct {
+	Resources []Summary `json:"resources"`
+}
+
+const (
+	lockPollInterval = 10 * time.Millisecond
+	lockStaleAge     = 30 * time.Second
+	lockTimeout      = 1 * time.Minute
+)
+
+// WriteFile merges the aggregated metrics into the JSON summary in the specified file, creating it if necessary.
+// Terraform runs a separate provider process for each command phase (e.g. plan and apply) and for each provider
+// configuration, so the file is updated under a lock and summaries for the same resource type and operation are added together.
+func (r *Recorder) WriteFile(name string) error {
+	unlock, err := lockFile(name)
+
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	merged := NewRecorder()
+
+	if b, err := os.ReadFile(name); err == nil {
+		var existing report
+
+		if err := json.Unmarshal(b, &existing); err != nil {
+			return fmt.Errorf("reading %s: %w", name, err)
+		}
+
+		for _, v := range existing.Resources {
+			merged.merge(v)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	for _, v := range r.Summaries() {
+		merged.merge(v)
+	}
+
+	b, err := json.MarshalIndent(report{Resources: merged.Summaries()}, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so that readers never see a partially written summary.
+	tmp := name + ".tmp"
+
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, name)
+}
+
+// merge adds the specified Summary to the Recorder's aggregated metrics.
+func (r *Recorder) merge(s Summary) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	k := summaryKey{
+		typeName:   s.TypeName,
+		dataSource: s.DataSource,
+		operation:  s.Operation,
+	}
+	v, ok := r.summaries[k]
+	if !ok {
+		v = &Summary{
+			TypeName:   s.TypeName,
+			DataSource: s.DataSource,
+			Operation:  s.Operation,
+		}
+		r.summaries[k] = v
+	}
+
+	v.Count += s.Count
+	v.Errors += s.Errors
+	v.WallTimeMS += s.WallTimeMS
+	v.APITimeMS += s.APITimeMS
+	v.WaitTimeMS += s.WaitTimeMS
+	v.APICalls += s.APICalls
+	v.Retries += s.Retries
+	v.Throttles += s.Throttles
+	v.MaxWallTimeMS = max(v.MaxWallTimeMS, s.MaxWallTimeMS)
+}
+
+// lockFile acquires an exclusive lock on the specified file, shared between processes, by creating a lock file alongside it.
+// A lock file older than lockStaleAge is assumed to have been left by a process that exited without unlocking and is removed.
+func lockFile(name string) (func(), error) {
+	lockName := name + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		f, err := os.OpenFile(lockName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+
+		if err == nil {
+			f.Close()
+
+			return func() { os.Remove(lockName) }, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if fi, err := os.Stat(lockName); err == nil && time.Since(fi.ModTime()) > lockStaleAge {
+			os.Remove(lockName)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for lock %s", lockName)
+		}
+
+		time.Sleep(lockPollInterval)
+	}
+}
+
+var defaultRecorder = NewRecorder()
+
+// Record aggregates the Operation stored in Context into the default Recorder.
+func Record(ctx context.Context, typeName string, dataSource bool, operation string, err error) {
+	if op, ok := FromContext(ctx); ok {
+		defaultRecorder.Record(typeName, dataSource, operation, op, err)
+	}
+}
+
+// WriteSummary merges the default Recorder's aggregated metrics into the file named by TF_AWS_METRICS_FILE.
+// It is a no-op if metrics recording is not enabled.
+func WriteSummary() error {
+	name := os.Getenv(EnvVar)
+
+	if name == "" {
+		return nil
+	}
+
+	return defaultRecorder.WriteFile(name)
+}
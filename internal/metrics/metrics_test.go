@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRecorderRecord(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+
+	op1 := newOperation()
+	op1.addAPICall(0)
+	op1.addAPICall(0)
+	op1.addAttempts(3)
+	op1.addThrottle()
+	r.Record("aws_test", false, "Create", op1, nil)
+
+	op2 := newOperation()
+	op2.addAPICall(0)
+	op2.addAttempts(1)
+	r.Record("aws_test", false, "Create", op2, errors.New("test"))
+
+	op3 := newOperation()
+	r.Record("aws_test", true, "Read", op3, nil)
+
+	got := r.Summaries()
+	want := []Summary{
+		{
+			TypeName:  "aws_test",
+			Operation: "Create",
+			Count:     2,
+			Errors:    1,
+			APICalls:  3,
+			Retries:   1,
+			Throttles: 1,
+		},
+		{
+			TypeName:   "aws_test",
+			DataSource: true,
+			Operation:  "Read",
+			Count:      1,
+		},
+	}
+
+	ignoreTimes := cmpopts.IgnoreFields(Summary{}, "WallTimeMS", "APITimeMS", "WaitTimeMS", "MaxWallTimeMS")
+	if diff := cmp.Diff(got, want, ignoreTimes); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRecorderWriteFile(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "metrics.json")
+
+	// Simulate the provider processes for plan and apply writing to the same file.
+	plan := NewRecorder()
+	plan.merge(Summary{TypeName: "aws_test", Operation: "Read", Count: 1, WallTimeMS: 10, APICalls: 1, MaxWallTimeMS: 10})
+	apply := NewRecorder()
+	apply.merge(Summary{TypeName: "aws_test", Operation: "Read", Count: 2, WallTimeMS: 30, APICalls: 2, MaxWallTimeMS: 20})
+	apply.merge(Summary{TypeName: "aws_test", Operation: "Create", Count: 1, WallTimeMS: 100, APICalls: 5, Retries: 1, MaxWallTimeMS: 100})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, r := range []*Recorder{plan, apply} {
+		i, r := i, r
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = r.WriteFile(name)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatalf("writing metrics: %s", err)
+	}
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got report
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := report{
+		Resources: []Summary{
+			{TypeName: "aws_test", Operation: "Create", Count: 1, WallTimeMS: 100, APICalls: 5, Retries: 1, MaxWallTimeMS: 100},
+			{TypeName: "aws_test", Operation: "Read", Count: 3, WallTimeMS: 40, APICalls: 3, MaxWallTimeMS: 20},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := os.Stat(name + ".lock"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("lock file not removed: %v", err)
+	}
+}
+
+func TestRecorderWriteFileStaleLock(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "metrics.json")
+	lockName := name + ".lock"
+
+	if err := os.WriteFile(lockName, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	stale := time.Now().Add(-2 * lockStaleAge)
+	if err := os.Chtimes(lockName, stale, stale); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewRecorder()
+	r.merge(Summary{TypeName: "aws_test", Operation: "Read", Count: 1})
+
+	if err := r.WriteFile(name); err != nil {
+		t.Fatalf("writing metrics: %s", err)
+	}
+
+	if _, err := os.Stat(name); err != nil {
+		t.Errorf("metrics file not written: %s", err)
+	}
+}
+
+func TestAddSDKv2Middleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err           error
+		wantAPICalls  int64
+		wantAttempts  int64
+		wantThrottles int64
+	}{
+		"success": {
+			wantAPICalls: 1,
+			wantAttempts: 1,
+		},
+		"throttled": {
+			err:           &smithy.GenericAPIError{Code: "ThrottlingException"},
+			wantAPICalls:  1,
+			wantAttempts:  1,
+			wantThrottles: 1,
+		},
+		"other error": {
+			err:          &smithy.GenericAPIError{Code: "ValidationException"},
+			wantAPICalls: 1,
+			wantAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stack := middleware.NewStack("test", func() any { return nil })
+			if err := AddSDKv2Middleware(stack); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, testCase.err
+			}), stack)
+
+			ctx := NewContext(context.Background())
+			_, _, err := handler.Handle(ctx, nil)
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			op, _ := FromContext(ctx)
+
+			if got, want := op.apiCalls.Load(), testCase.wantAPICalls; got != want {
+				t.Errorf("API calls = %d, want %d", got, want)
+			}
+			if got, want := op.attempts.Load(), testCase.wantAttempts; got != want {
+				t.Errorf("attempts = %d, want %d", got, want)
+			}
+			if got, want := op.throttles.Load(), testCase.wantThrottles; got != want {
+				t.Errorf("throttles = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAddSDKv2MiddlewareNoOperation(t *testing.T) {
+	t.Parallel()
+
+	stack := middleware.NewStack("test", func() any { return nil })
+	if err := AddSDKv2Middleware(stack); err != nil {
+		t.Fatalf("adding middleware: %s", err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	if _, _, err := handler.Handle(context.Background(), nil); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	apiCallMiddlewareID    = "TerraformAWSProvider:MetricsAPICall"
+	apiAttemptMiddlewareID = "TerraformAWSProvider:MetricsAPIAttempt"
+)
+
+// AddSDKv2Middleware adds the metrics recording middleware to an AWS SDK for Go v2 middleware stack.
+// It is suitable for use as an `aws.Config` APIOptions entry.
+func AddSDKv2Middleware(stack *middleware.Stack) error {
+	// Record each API call, including any retries, as late in the Initialize step as possible.
+	if err := stack.Initialize.Add(apiCallMiddleware(), middleware.After); err != nil {
+		return err
+	}
+
+	// Record each API call attempt. The Retry middleware is in the Finalize step.
+	id := (&retry_sdkv2.Attempt{}).ID()
+	if _, ok := stack.Finalize.Get(id); ok {
+		return stack.Finalize.Insert(apiAttemptMiddleware(), id, middleware.After)
+	}
+
+	return stack.Finalize.Add(apiAttemptMiddleware(), middleware.After)
+}
+
+func apiCallMiddleware() middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(
+		apiCallMiddlewareID,
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (out middleware.InitializeOutput, metadata middleware.Metadata, err error) {
+			op, ok := FromContext(ctx)
+			if !ok {
+				return next.HandleInitialize(ctx, in)
+			}
+
+			start := time.Now()
+			out, metadata, err = next.HandleInitialize(ctx, in)
+			op.addAPICall(time.Since(start))
+
+			return out, metadata, err
+		},
+	)
+}
+
+func apiAttemptMiddleware() middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc(
+		apiAttemptMiddlewareID,
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (out middleware.FinalizeOutput, metadata middleware.Metadata, err error) {
+			op, ok := FromContext(ctx)
+			if !ok {
+				return next.HandleFinalize(ctx, in)
+			}
+
+			out, metadata, err = next.HandleFinalize(ctx, in)
+			op.addAttempts(1)
+			if err != nil && retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary {
+				op.addThrottle()
+			}
+
+			return out, metadata, err
+		},
+	)
+}
+
+// AddSDKv1Handlers adds the metrics recording handlers to an AWS SDK for Go v1 handler list.
+// Service clients copy their session's handlers on creation, so this must be called before any clients are created.
+func AddSDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	handlers.AfterRetry.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: apiAttemptMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			if op, ok := FromContext(r.Context()); ok {
+				if r.Error != nil && request_sdkv1.IsErrorThrottle(r.Error) {
+					op.addThrottle()
+				}
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: apiCallMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			if op, ok := FromContext(r.Context()); ok {
+				op.addAPICall(time.Since(r.Time))
+				op.addAttempts(int64(r.RetryCount) + 1)
+			}
+		},
+	})
+}
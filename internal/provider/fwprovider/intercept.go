@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,7 +15,10 @@ import (
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptor"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -22,7 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// A data source interceptor is functionality invoked during the data source's CRUD request lifecycle.
+// A data source interceptor is functionality invoked during the data source's request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
 // In other cases all interceptors in the chain are run.
@@ -33,14 +37,25 @@ type dataSourceInterceptor interface {
 
 type dataSourceInterceptors []dataSourceInterceptor
 
-type resourceCRUDRequest interface {
-	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
+// read returns a slice of interceptors that run on data source Read.
+func (s dataSourceInterceptors) read() []interceptorFunc[datasource.ReadRequest, datasource.ReadResponse] {
+	return slices.ApplyToAll(s, func(e dataSourceInterceptor) interceptorFunc[datasource.ReadRequest, datasource.ReadResponse] {
+		return e.read
+	})
+}
+
+type interceptedRequest interface {
+	datasource.ReadRequest |
+		resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest |
+		resource.ImportStateRequest | resource.ModifyPlanRequest | resource.UpgradeStateRequest
 }
-type resourceCRUDResponse interface {
-	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse
+type interceptedResponse interface {
+	datasource.ReadResponse |
+		resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse |
+		resource.ImportStateResponse | resource.ModifyPlanResponse | resource.UpgradeStateResponse
 }
 
-// A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
+// A resource interceptor is functionality invoked during the resource's request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
 // In other cases all interceptors in the chain are run.
@@ -53,53 +68,82 @@ type resourceInterceptor interface {
 	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 	// delete is invoke for a Delete call.
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// importState is invoke for an ImportState call.
+	importState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// modifyPlan is invoke for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// upgradeState is invoke for an UpgradeState call.
+	upgradeState(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type resourceInterceptors []resourceInterceptor
 
-type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+type interceptorFunc[Request interceptedRequest, Response interceptedResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // create returns a slice of interceptors that run on resource Create.
-func (s resourceInterceptors) create() []resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+func (s resourceInterceptors) create() []interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
 		return e.create
 	})
 }
 
 // read returns a slice of interceptors that run on resource Read.
-func (s resourceInterceptors) read() []resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+func (s resourceInterceptors) read() []interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
 		return e.read
 	})
 }
 
 // update returns a slice of interceptors that run on resource Update.
-func (s resourceInterceptors) update() []resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+func (s resourceInterceptors) update() []interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
 		return e.update
 	})
 }
 
 // delete returns a slice of interceptors that run on resource Delete.
-func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+func (s resourceInterceptors) delete() []interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
 		return e.delete
 	})
 }
 
-// when represents the point in the CRUD request lifecycle that an interceptor is run.
-// Multiple values can be ORed together.
-type when uint16
+// importState returns a slice of interceptors that run on resource ImportState.
+func (s resourceInterceptors) importState() []interceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+		return e.importState
+	})
+}
+
+// modifyPlan returns a slice of interceptors that run on resource ModifyPlan.
+func (s resourceInterceptors) modifyPlan() []interceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+		return e.modifyPlan
+	})
+}
+
+// upgradeState returns a slice of interceptors that run on resource UpgradeState.
+func (s resourceInterceptors) upgradeState() []interceptorFunc[resource.UpgradeStateRequest, resource.UpgradeStateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.UpgradeStateRequest, resource.UpgradeStateResponse] {
+		return e.upgradeState
+	})
+}
+
+// The when and why of the interceptor model are shared with Plugin SDK resources and data sources.
+type (
+	when = interceptor.When
+	why  = interceptor.Why
+)
 
 const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Before  = interceptor.Before
+	After   = interceptor.After
+	OnError = interceptor.OnError
+	Finally = interceptor.Finally
 )
 
-// interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
+// interceptedHandler returns a handler that invokes the specified handler, running any interceptors.
+func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
 		// Before interceptors are run first to last.
@@ -168,9 +212,13 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		w.inner.Read(ctx, request, response)
+		return response.Diagnostics
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	// TODO Run interceptors.
-	w.inner.Read(ctx, request, response)
+	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -268,8 +316,13 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+		f := func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) diag.Diagnostics {
+			v.ImportState(ctx, request, response)
+			return response.Diagnostics
+		}
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		diags := interceptedHandler(w.interceptors.importState(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
 
 		return
 	}
@@ -281,12 +334,15 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
-
-		return
+	f := func(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			v.ModifyPlan(ctx, request, response)
+		}
+		return response.Diagnostics
 	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedHandler(w.interceptors.modifyPlan(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -308,13 +364,107 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		stateUpgraders := v.UpgradeState(ctx)
+
+		for k, stateUpgrader := range stateUpgraders {
+			if upgrade := stateUpgrader.StateUpgrader; upgrade != nil {
+				f := func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) diag.Diagnostics {
+					upgrade(ctx, request, response)
+					return response.Diagnostics
+				}
+				stateUpgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					ctx = w.bootstrapContext(ctx, w.meta)
+					diags := interceptedHandler(w.interceptors.upgradeState(), f, w.meta)(ctx, request, response)
+					response.Diagnostics = diags
+				}
+				stateUpgraders[k] = stateUpgrader
+			}
+		}
 
-		return v.UpgradeState(ctx)
+		return stateUpgraders
 	}
 
 	return nil
 }
 
+// genericInterceptor adapts a generic interceptor for use with Plugin Framework resources and data sources.
+type genericInterceptor struct {
+	item interceptor.Item
+}
+
+func (r genericInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.item.When&when == 0 || r.item.Why&why == 0 {
+		return ctx, diags
+	}
+
+	var err error
+	if when&(OnError|Finally) != 0 {
+		err = fwdiag.DiagnosticsError(diags)
+	}
+
+	ctx, err = r.item.Interceptor.Run(ctx, meta, when, why, err)
+
+	if err != nil {
+		diags.AddError(err.Error(), "")
+	}
+
+	return ctx, diags
+}
+
+// genericDataSourceInterceptor adapts a generic interceptor for use with Plugin Framework data sources.
+type genericDataSourceInterceptor struct {
+	genericInterceptor
+}
+
+func newGenericDataSourceInterceptors(items interceptor.Items) dataSourceInterceptors {
+	return slices.ApplyToAll(items, func(v interceptor.Item) dataSourceInterceptor {
+		return genericDataSourceInterceptor{genericInterceptor{item: v}}
+	})
+}
+
+func (r genericDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.Read, diags)
+}
+
+// genericResourceInterceptor adapts a generic interceptor for use with Plugin Framework resources.
+type genericResourceInterceptor struct {
+	genericInterceptor
+}
+
+func newGenericResourceInterceptors(items interceptor.Items) resourceInterceptors {
+	return slices.ApplyToAll(items, func(v interceptor.Item) resourceInterceptor {
+		return genericResourceInterceptor{genericInterceptor{item: v}}
+	})
+}
+
+func (r genericResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.Create, diags)
+}
+
+func (r genericResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.Read, diags)
+}
+
+func (r genericResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.Update, diags)
+}
+
+func (r genericResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.Delete, diags)
+}
+
+func (r genericResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.ImportState, diags)
+}
+
+func (r genericResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.ModifyPlan, diags)
+}
+
+func (r genericResourceInterceptor) upgradeState(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, interceptor.UpgradeState, diags)
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -346,6 +496,13 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		// Enforce any provider configured required_tags. Tags that were unknown at plan time are first known here.
+		diags = validateRequiredTags(inContext, meta, tags, diags)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -511,6 +668,13 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		// Enforce any provider configured required_tags. Tags that were unknown at plan time are first known here.
+		diags = validateRequiredTags(inContext, meta, tags, diags)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -578,3 +742,71 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+func (r tagsResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil {
+		return ctx, diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if when != After || response.Plan.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	// Unknown tags are checked during Create or Update.
+	if planTags.IsUnknown() || slices.Any(maps.Values(planTags.Elements()), attr.Value.IsUnknown) {
+		return ctx, diags
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+
+	diags = validateRequiredTags(inContext, meta, tags, diags)
+
+	// Enforce any service tag constraints, e.g. maximum number of tags.
+	if meta != nil {
+		if err := meta.TagConstraintsForContext(ctx).Validate(tags.IgnoreConfig(meta.IgnoreTagsConfig)); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrTags), "Tag Constraints Not Satisfied", fmt.Sprintf("tags_all does not satisfy the service's tag constraints:\n%s", err))
+		}
+	}
+
+	return ctx, diags
+}
+
+func (r tagsResourceInterceptor) upgradeState(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// validateRequiredTags adds an error diagnostic if the resource's merged tags do not satisfy any provider configured required_tags.
+func validateRequiredTags(inContext *conns.InContext, meta *conns.AWSClient, tags tftags.KeyValueTags, diags diag.Diagnostics) diag.Diagnostics {
+	if meta == nil {
+		return diags
+	}
+
+	if err := meta.RequiredTagsConfig.Validate(inContext.TypeName, tags); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrTags), "Required Tags Not Satisfied", fmt.Sprintf("tags_all does not satisfy the provider's required_tags:\n%s", err))
+	}
+
+	return diags
+}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +20,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -30,6 +35,8 @@ func New(primary interface{ Meta() interface{} }) provider.Provider {
 	}
 }
 
+var _ provider.ProviderWithFunctions = (*fwprovider)(nil)
+
 type fwprovider struct {
 	Primary interface{ Meta() interface{} }
 }
@@ -250,12 +257,46 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to not default `tags` on. A trailing `*` matches any suffix.",
+						},
+						"include_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_s3_bucket`, to default `tags` on. A trailing `*` matches any suffix. If not set, `tags` are defaulted on all resources not excluded.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scoped_tags": schema.ListNestedBlock{
+							Description: "Configuration block with additional resource tags to default across selected resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types to not default `tags` on. A trailing `*` matches any suffix.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types to default `tags` on. A trailing `*` matches any suffix. If not set, `tags` are defaulted on all resources not excluded.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across selected resource types.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -271,12 +312,70 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching whole resource tag keys to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tag keys to ignore across all resources.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Configuration block matching resource tags to ignore across all resources by both key and value.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_regex": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression matching the whole resource tag key.",
+									},
+									"value_regex": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression matching the whole resource tag value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"required_tags": schema.ListNestedBlock{
+				Description: "Configuration block with a tag that must be present on taggable resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_values": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions, one of which must match the whole tag value. If not set, any value is allowed.",
+						},
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource tag key that must be present.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_instance`, the tag is required on. A trailing `*` matches any suffix. If not set, the tag is required on all taggable resources.",
+						},
+					},
+				},
+			},
+			"service_concurrency": schema.SetNestedBlock{
+				Description: "Configuration block limiting the number of concurrent AWS API requests to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of concurrent AWS API requests to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same name as in the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
 				},
 			},
 		},
@@ -324,15 +423,16 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
+					ctx = meta.RegisterVCR(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := newGenericDataSourceInterceptors(interceptor.Generic())
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -395,15 +495,16 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
+					ctx = meta.RegisterVCR(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := newGenericResourceInterceptors(interceptor.Generic())
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -448,6 +549,67 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function name is determined by the Function implementing
+// the Metadata method. All functions must have unique names.
+func (p *fwprovider) Functions(ctx context.Context) []func() function.Function {
+	functions := []func() function.Function{
+		tffunction.NewARNBuildFunction,
+		tffunction.NewARNParseFunction,
+	}
+
+	functions, err := appendServicePackageFunctions(ctx, functions, p.Primary.Meta().(*conns.AWSClient).ServicePackages)
+
+	if err != nil {
+		tflog.Warn(ctx, "registering functions", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return functions
+}
+
+// appendServicePackageFunctions appends the functions implemented by the specified service packages to functions.
+// A function whose name duplicates that of an already appended function is skipped and an error returned.
+// Service packages are processed in name order so that the function registered for a duplicate name is stable.
+func appendServicePackageFunctions(ctx context.Context, functions []func() function.Function, servicePackages map[string]conns.ServicePackage) ([]func() function.Function, error) {
+	var errs []error
+
+	functionNames := make(map[string]struct{})
+	for _, v := range functions {
+		functionNames[functionName(ctx, v)] = struct{}{}
+	}
+
+	servicePackageNames := tfmaps.Keys(servicePackages)
+	slices.Sort(servicePackageNames)
+
+	for _, servicePackageName := range servicePackageNames {
+		for _, v := range servicePackages[servicePackageName].FrameworkFunctions(ctx) {
+			name := functionName(ctx, v.Factory)
+
+			if _, ok := functionNames[name]; ok {
+				errs = append(errs, fmt.Errorf("duplicate function name (%s): %s", servicePackageName, name))
+				continue
+			}
+			functionNames[name] = struct{}{}
+
+			functions = append(functions, v.Factory)
+		}
+	}
+
+	return functions, errors.Join(errs...)
+}
+
+// functionName returns the name of the function created by the specified factory.
+func functionName(ctx context.Context, factory func() function.Function) string {
+	response := function.MetadataResponse{}
+	factory().Metadata(ctx, function.MetadataRequest{}, &response)
+
+	return response.Name
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type testFunction struct {
+	name string
+}
+
+func (f testFunction) Metadata(_ context.Context, request function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = f.name
+}
+
+func (f testFunction) Definition(context.Context, function.DefinitionRequest, *function.DefinitionResponse) {
+}
+
+func (f testFunction) Run(context.Context, function.RunRequest, *function.RunResponse) {
+}
+
+func newTestFunction(name string) func() function.Function {
+	return func() function.Function {
+		return testFunction{name: name}
+	}
+}
+
+type testServicePackage struct {
+	conns.ServicePackage
+
+	functions []*types.ServicePackageFrameworkFunction
+}
+
+func (sp testServicePackage) FrameworkFunctions(context.Context) []*types.ServicePackageFrameworkFunction {
+	return sp.functions
+}
+
+func TestAppendServicePackageFunctions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		servicePackages map[string]conns.ServicePackage
+		expectedNames   []string
+		expectedError   bool
+	}{
+		"no service packages": {
+			expectedNames: []string{"arn_parse"},
+		},
+		"no functions": {
+			servicePackages: map[string]conns.ServicePackage{
+				"iam": testServicePackage{},
+			},
+			expectedNames: []string{"arn_parse"},
+		},
+		"functions": {
+			servicePackages: map[string]conns.ServicePackage{
+				"ec2": testServicePackage{
+					functions: []*types.ServicePackageFrameworkFunction{
+						{Factory: newTestFunction("cidr_subnets")},
+					},
+				},
+				"iam": testServicePackage{
+					functions: []*types.ServicePackageFrameworkFunction{
+						{Factory: newTestFunction("trim_iam_role_path")},
+					},
+				},
+			},
+			expectedNames: []string{"arn_parse", "cidr_subnets", "trim_iam_role_path"},
+		},
+		"duplicates provider function": {
+			servicePackages: map[string]conns.ServicePackage{
+				"iam": testServicePackage{
+					functions: []*types.ServicePackageFrameworkFunction{
+						{Factory: newTestFunction("arn_parse")},
+						{Factory: newTestFunction("trim_iam_role_path")},
+					},
+				},
+			},
+			expectedNames: []string{"arn_parse", "trim_iam_role_path"},
+			expectedError: true,
+		},
+		"duplicates service package function": {
+			servicePackages: map[string]conns.ServicePackage{
+				"ec2": testServicePackage{
+					functions: []*types.ServicePackageFrameworkFunction{
+						{Factory: newTestFunction("example")},
+					},
+				},
+				"iam": testServicePackage{
+					functions: []*types.ServicePackageFrameworkFunction{
+						{Factory: newTestFunction("example")},
+					},
+				},
+			},
+			expectedNames: []string{"arn_parse", "example"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			functions, err := appendServicePackageFunctions(ctx, []func() function.Function{newTestFunction("arn_parse")}, testCase.servicePackages)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("got error %v, expected error %t", err, want)
+			}
+
+			var names []string
+			for _, v := range functions {
+				names = append(names, functionName(ctx, v))
+			}
+
+			if diff := cmp.Diff(names, testCase.expectedNames); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
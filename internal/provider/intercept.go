@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfinterceptor "github.com/hashicorp/terraform-provider-aws/internal/provider/interceptor"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -33,7 +34,7 @@ type schemaResourceData interface {
 	Set(string, any) error
 }
 
-// An interceptor is functionality invoked during the CRUD request lifecycle.
+// An interceptor is functionality invoked during the request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
 // In other cases all interceptors in the chain are run.
@@ -54,28 +55,30 @@ type interceptorItem struct {
 	interceptor interceptor
 }
 
-// when represents the point in the CRUD request lifecycle that an interceptor is run.
-// Multiple values can be ORed together.
-type when uint16
+// The when and why of the interceptor model are shared with Plugin Framework resources and data sources.
+type (
+	when = tfinterceptor.When
+	why  = tfinterceptor.Why
+)
 
 const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Before  = tfinterceptor.Before
+	After   = tfinterceptor.After
+	OnError = tfinterceptor.OnError
+	Finally = tfinterceptor.Finally
 )
 
-// why represents the CRUD operation(s) that an interceptor is run.
-// Multiple values can be ORed together.
-type why uint16
-
 const (
-	Create why = 1 << iota // Interceptor is invoked for a Create call
-	Read                   // Interceptor is invoked for a Read call
-	Update                 // Interceptor is invoked for an Update call
-	Delete                 // Interceptor is invoked for a Delete call
-
-	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
+	Create       = tfinterceptor.Create
+	Read         = tfinterceptor.Read
+	Update       = tfinterceptor.Update
+	Delete       = tfinterceptor.Delete
+	ImportState  = tfinterceptor.ImportState
+	ModifyPlan   = tfinterceptor.ModifyPlan
+	UpgradeState = tfinterceptor.UpgradeState
+
+	AllCRUDOps = tfinterceptor.AllCRUDOps
+	AllOps     = tfinterceptor.AllOps
 )
 
 type interceptorItems []interceptorItem
@@ -90,47 +93,56 @@ func (s interceptorItems) why(why why) interceptorItems {
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
 func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
-		// Before interceptors are run first to last.
-		forward := interceptors.why(why)
 
-		when := Before
-		for _, v := range forward {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+		return runInterceptors(ctx, interceptors, d, meta, why, func(ctx context.Context) diag.Diagnostics {
+			return f(ctx, d, meta)
+		})
+	}
+}
 
-				// Short circuit if any Before interceptor errors.
-				if diags.HasError() {
-					return diags
-				}
+// runInterceptors invokes f, running any interceptors for the specified operation.
+// d is nil for operations that do not have access to schema.ResourceData.
+func runInterceptors(ctx context.Context, interceptors interceptorItems, d schemaResourceData, meta any, why why, f func(context.Context) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// Before interceptors are run first to last.
+	forward := interceptors.why(why)
+
+	when := Before
+	for _, v := range forward {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				return diags
 			}
 		}
+	}
 
-		// All other interceptors are run last to first.
-		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+	// All other interceptors are run last to first.
+	reverse := slices.Reverse(forward)
+	diags = f(ctx)
 
-		if diags.HasError() {
-			when = OnError
-		} else {
-			when = After
-		}
-		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
-			}
+	if diags.HasError() {
+		when = OnError
+	} else {
+		when = After
+	}
+	for _, v := range reverse {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 		}
+	}
 
-		when = Finally
-		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
-			}
+	when = Finally
+	for _, v := range reverse {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 		}
-
-		return diags
 	}
+
+	return diags
 }
 
 // contextFunc augments Context.
@@ -172,26 +184,71 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		var output []*schema.ResourceData
 		ctx = r.bootstrapContext(ctx, meta)
+		diags := runInterceptors(ctx, r.interceptors, d, meta, ImportState, func(ctx context.Context) diag.Diagnostics {
+			var err error
+			output, err = f(ctx, d, meta)
 
-		return f(ctx, d, meta)
+			return sdkdiag.AppendFromErr(nil, err)
+		})
+
+		return output, sdkdiag.DiagnosticsError(diags)
 	}
 }
 
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
+		diags := runInterceptors(ctx, r.interceptors, nil, meta, ModifyPlan, func(ctx context.Context) diag.Diagnostics {
+			return sdkdiag.AppendFromErr(nil, f(ctx, d, meta))
+		})
 
-		return f(ctx, d, meta)
+		return sdkdiag.DiagnosticsError(diags)
 	}
 }
 
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
+		var output map[string]interface{}
 		ctx = r.bootstrapContext(ctx, meta)
+		diags := runInterceptors(ctx, r.interceptors, nil, meta, UpgradeState, func(ctx context.Context) diag.Diagnostics {
+			var err error
+			output, err = f(ctx, rawState, meta)
+
+			return sdkdiag.AppendFromErr(nil, err)
+		})
+
+		return output, sdkdiag.DiagnosticsError(diags)
+	}
+}
+
+// genericInterceptor adapts a generic interceptor for use with Plugin SDK resources and data sources.
+type genericInterceptor struct {
+	interceptor tfinterceptor.Interceptor
+}
 
-		return f(ctx, rawState, meta)
+func (r genericInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	var err error
+	if when&(OnError|Finally) != 0 {
+		err = sdkdiag.DiagnosticsError(diags)
 	}
+
+	v, _ := meta.(*conns.AWSClient)
+	ctx, err = r.interceptor.Run(ctx, v, when, why, err)
+
+	return ctx, sdkdiag.AppendFromErr(diags, err)
+}
+
+// genericInterceptorItems returns the specified generic interceptors as Plugin SDK interceptors.
+func genericInterceptorItems(items tfinterceptor.Items) interceptorItems {
+	return slices.ApplyToAll(items, func(v tfinterceptor.Item) interceptorItem {
+		return interceptorItem{
+			when:        v.When,
+			why:         v.Why,
+			interceptor: genericInterceptor{interceptor: v.Interceptor},
+		}
+	})
 }
 
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -239,6 +296,10 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
 			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+			// Enforce any provider configured required_tags. Tags that were unknown at plan time are first known here.
+			if err := meta.(*conns.AWSClient).RequiredTagsConfig.Validate(inContext.TypeName, tags); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "%s %s: tags_all does not satisfy the provider's required_tags:\n%s", serviceName, resourceName, err)
+			}
 			// Remove system tags.
 			tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package interceptor defines the interceptor model shared by Terraform Plugin SDK v2 and
+// Terraform Plugin Framework resources and data sources.
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// When represents the point in the request lifecycle that an interceptor is run.
+// Multiple values can be ORed together.
+type When uint16
+
+const (
+	Before  When = 1 << iota // Interceptor is invoked before call to method in schema
+	After                    // Interceptor is invoked after successful call to method in schema
+	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
+	Finally                  // Interceptor is invoked after After or OnError
+)
+
+// Why represents the operation(s) that an interceptor is run.
+// Multiple values can be ORed together.
+type Why uint16
+
+const (
+	Create       Why = 1 << iota // Interceptor is invoked for a Create call
+	Read                         // Interceptor is invoked for a Read call
+	Update                       // Interceptor is invoked for an Update call
+	Delete                       // Interceptor is invoked for a Delete call
+	ImportState                  // Interceptor is invoked for an ImportState call
+	ModifyPlan                   // Interceptor is invoked for a ModifyPlan (Plugin SDK CustomizeDiff) call
+	UpgradeState                 // Interceptor is invoked for an UpgradeState call
+
+	AllCRUDOps = Create | Read | Update | Delete                      // Interceptor is invoked for all CRUD calls
+	AllOps     = AllCRUDOps | ImportState | ModifyPlan | UpgradeState // Interceptor is invoked for all calls
+)
+
+func (why Why) String() string {
+	var ops []string
+
+	for _, v := range []struct {
+		why  Why
+		name string
+	}{
+		{Create, "Create"},
+		{Read, "Read"},
+		{Update, "Update"},
+		{Delete, "Delete"},
+		{ImportState, "ImportState"},
+		{ModifyPlan, "ModifyPlan"},
+		{UpgradeState, "UpgradeState"},
+	} {
+		if why&v.why != 0 {
+			ops = append(ops, v.name)
+		}
+	}
+
+	return strings.Join(ops, "|")
+}
+
+// An Interceptor is functionality invoked during the request lifecycle of any resource or data source,
+// regardless of whether it is implemented using Terraform Plugin SDK v2 or Terraform Plugin Framework.
+// Interceptors have no access to resource data, making them suitable for cross-cutting concerns such as
+// logging, metrics or guards. Resource metadata is available via conns.FromContext.
+//
+// err is the error returned from the schema's method for OnError and Finally interceptors, and nil otherwise.
+// meta is nil if the provider has not yet been configured.
+// A non-nil error returned from Run is reported as an error diagnostic.
+// If a Before interceptor returns an error then no further interceptors in the chain are run and neither is the schema's method.
+type Interceptor interface {
+	Run(ctx context.Context, meta *conns.AWSClient, when When, why Why, err error) (context.Context, error)
+}
+
+type InterceptorFunc func(context.Context, *conns.AWSClient, When, Why, error) (context.Context, error)
+
+func (f InterceptorFunc) Run(ctx context.Context, meta *conns.AWSClient, when When, why Why, err error) (context.Context, error) {
+	return f(ctx, meta, when, why, err)
+}
+
+// Item represents a single interceptor invocation.
+type Item struct {
+	When        When
+	Why         Why
+	Interceptor Interceptor
+}
+
+type Items []Item
+
+// Why returns a slice of interceptors that run for the specified operation.
+func (s Items) Why(why Why) Items {
+	return slices.Filter(s, func(e Item) bool {
+		return e.Why&why != 0
+	})
+}
+
+// Generic returns the interceptors that are run for all resources and data sources.
+func Generic() Items {
+	items := Items{
+		{
+			When:        Before | Finally,
+			Why:         AllOps,
+			Interceptor: loggingInterceptor{},
+		},
+	}
+
+	// Metrics recording is opt-in.
+	if metrics.Enabled() {
+		items = append(items, Item{
+			When:        Before | Finally,
+			Why:         AllOps,
+			Interceptor: metricsInterceptor{},
+		})
+	}
+
+	return items
+}
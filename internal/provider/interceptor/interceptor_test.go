@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestItemsWhy(t *testing.T) {
+	t.Parallel()
+
+	f := InterceptorFunc(func(ctx context.Context, meta *conns.AWSClient, when When, why Why, err error) (context.Context, error) {
+		return ctx, nil
+	})
+	interceptors := Items{
+		{When: Before, Why: Create, Interceptor: f},
+		{When: After, Why: Delete | ImportState, Interceptor: f},
+		{When: Before, Why: AllOps, Interceptor: f},
+	}
+
+	for _, testCase := range []struct {
+		why  Why
+		want int
+	}{
+		{Create, 2},
+		{Read, 1},
+		{Update, 1},
+		{Delete, 2},
+		{ImportState, 2},
+		{ModifyPlan, 1},
+		{UpgradeState, 1},
+	} {
+		if got, want := len(interceptors.Why(testCase.why)), testCase.want; got != want {
+			t.Errorf("length of interceptors.Why(%s) = %v, want %v", testCase.why, got, want)
+		}
+	}
+}
+
+func TestWhyString(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		why  Why
+		want string
+	}{
+		{Create, "Create"},
+		{ModifyPlan, "ModifyPlan"},
+		{Create | Read | Update, "Create|Read|Update"},
+		{AllCRUDOps, "Create|Read|Update|Delete"},
+	} {
+		if got, want := testCase.why.String(), testCase.want; got != want {
+			t.Errorf("%d.String() = %q, want %q", testCase.why, got, want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type startTimeContextKeyType int
+
+var startTimeContextKey startTimeContextKeyType
+
+// loggingInterceptor logs the start and end of each operation.
+type loggingInterceptor struct{}
+
+func (loggingInterceptor) Run(ctx context.Context, meta *conns.AWSClient, when When, why Why, err error) (context.Context, error) {
+	fields := map[string]any{
+		"tf_operation": why.String(),
+	}
+	if inContext, ok := conns.FromContext(ctx); ok {
+		fields["tf_service_package"] = inContext.ServicePackageName
+		fields["tf_resource_name"] = inContext.ResourceName
+		fields["tf_data_source"] = inContext.IsDataSource
+	}
+
+	switch when {
+	case Before:
+		tflog.Debug(ctx, "Starting operation", fields)
+
+		return context.WithValue(ctx, startTimeContextKey, time.Now()), nil
+	case Finally:
+		if v, ok := ctx.Value(startTimeContextKey).(time.Time); ok {
+			fields["tf_duration_ms"] = time.Since(v).Milliseconds()
+		}
+		fields["tf_error"] = err != nil
+
+		tflog.Debug(ctx, "Finished operation", fields)
+	}
+
+	return ctx, nil
+}
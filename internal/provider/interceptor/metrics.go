@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
+)
+
+// metricsInterceptor records per-resource, per-operation wall time and AWS API call metrics.
+type metricsInterceptor struct{}
+
+func (metricsInterceptor) Run(ctx context.Context, meta *conns.AWSClient, when When, why Why, err error) (context.Context, error) {
+	switch when {
+	case Before:
+		return metrics.NewContext(ctx), nil
+	case Finally:
+		if inContext, ok := conns.FromContext(ctx); ok {
+			metrics.Record(ctx, inContext.TypeName, inContext.IsDataSource, why.String(), err)
+		}
+	}
+
+	return ctx, nil
+}
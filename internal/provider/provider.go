@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfinterceptor "github.com/hashicorp/terraform-provider-aws/internal/provider/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -65,6 +66,45 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to not default `tags` on. A trailing `*` matches any suffix.",
+						},
+						"include_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_s3_bucket`, to default `tags` on. A trailing `*` matches any suffix. If not set, `tags` are defaulted on all resources not excluded.",
+						},
+						"scoped_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with additional resource tags to default across selected resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types to not default `tags` on. A trailing `*` matches any suffix.",
+									},
+									"include_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types to default `tags` on. A trailing `*` matches any suffix. If not set, `tags` are defaulted on all resources not excluded.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across selected resource types.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -124,6 +164,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching whole resource tag keys to ignore across all resources.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block matching resource tags to ignore across all resources by both key and value.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_regex": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the whole resource tag key.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the whole resource tag value.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -158,6 +228,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with a tag that must be present on taggable resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions, one of which must match the whole tag value. If not set, any value is allowed.",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource tag key that must be present.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_instance`, the tag is required on. A trailing `*` matches any suffix. If not set, the tag is required on all taggable resources.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +284,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block limiting the number of concurrent AWS API requests to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of concurrent AWS API requests to the service.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, using the same name as in the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -283,15 +402,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
+					ctx = v.RegisterVCR(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := genericInterceptorItems(tfinterceptor.Generic())
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -360,15 +480,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
+					ctx = v.RegisterVCR(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := genericInterceptorItems(tfinterceptor.Generic())
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -430,9 +551,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
-			for _, stateUpgrader := range r.StateUpgraders {
+			for i, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
-					stateUpgrader.Upgrade = rs.StateUpgrade(v)
+					r.StateUpgraders[i].Upgrade = rs.StateUpgrade(v)
 				}
 			}
 
@@ -558,13 +679,36 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, dx := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.IgnoreTagsConfig = ignoreTagsConfig
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 {
+		requiredTagsConfig, dx := expandRequiredTags(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RequiredTagsConfig = requiredTagsConfig
+	}
+
+	if v, ok := d.GetOk("service_concurrency"); ok && v.(*schema.Set).Len() > 0 {
+		serviceConcurrency, dx := expandServiceConcurrency(ctx, v.(*schema.Set).List())
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceConcurrency = serviceConcurrency
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -843,12 +987,50 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	defaultConfig.ResourceTypes = expandResourceTypeSelector(tfMap)
+
+	if v, ok := tfMap["scoped_tags"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			scopedTags := tftags.ScopedDefaultTags{
+				ResourceTypes: expandResourceTypeSelector(tfMap),
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				scopedTags.Tags = tftags.New(ctx, v)
+			}
+
+			defaultConfig.ScopedTags = append(defaultConfig.ScopedTags, scopedTags)
+		}
+	}
+
 	return defaultConfig
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandResourceTypeSelector(tfMap map[string]interface{}) tftags.ResourceTypeSelector {
+	var selector tftags.ResourceTypeSelector
+
+	if v, ok := tfMap["include_resource_types"].(*schema.Set); ok {
+		selector.Include = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+		selector.Exclude = flex.ExpandStringValueSet(v)
+	}
+
+	return selector
+}
+
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	if tfMap == nil {
-		return nil
+		return nil, diags
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -861,7 +1043,100 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		for _, pattern := range flex.ExpandStringValueSet(v) {
+			re, err := tftags.NewTagRegexp(pattern)
+
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "ignore_tags: key_regexes (%s): %s", pattern, err)
+			}
+
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, re)
+		}
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			ignoreTag, err := tftags.NewIgnoreTag(tfMap["key_regex"].(string), tfMap["value_regex"].(string))
+
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "ignore_tags: tag: %s", err)
+			}
+
+			ignoreConfig.Tags = append(ignoreConfig.Tags, ignoreTag)
+		}
+	}
+
+	return ignoreConfig, diags
+}
+
+func expandRequiredTags(_ context.Context, tfList []interface{}) (*tftags.RequiredConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var allowedValues, resourceTypes []string
+
+		if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+			allowedValues = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			resourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		requiredTag, err := tftags.NewRequiredTag(tfMap["key"].(string), allowedValues, resourceTypes)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "required_tags: %s", err)
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig, diags
+}
+
+func expandServiceConcurrency(_ context.Context, tfList []interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceConcurrency := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(service)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "service_concurrency: unsupported service (%s)", service)
+		}
+
+		if _, ok := serviceConcurrency[pkg]; ok {
+			return nil, sdkdiag.AppendErrorf(diags, "service_concurrency: duplicate service (%s)", service)
+		}
+
+		serviceConcurrency[pkg] = tfMap["limit"].(int)
+	}
+
+	return serviceConcurrency, diags
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
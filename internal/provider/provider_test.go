@@ -12,7 +12,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -59,6 +61,222 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfList    []interface{}
+		want      map[string]int
+		wantError bool
+	}{
+		"empty": {
+			want: map[string]int{},
+		},
+		"services": {
+			tfList: []interface{}{
+				map[string]interface{}{"service": "route53", "limit": 2},
+				map[string]interface{}{"service": "iam", "limit": 4},
+			},
+			want: map[string]int{
+				names.Route53: 2,
+				names.IAM:     4,
+			},
+		},
+		"alias": {
+			tfList: []interface{}{
+				map[string]interface{}{"service": "transcribeservice", "limit": 1},
+			},
+			want: map[string]int{
+				names.Transcribe: 1,
+			},
+		},
+		"duplicate": {
+			tfList: []interface{}{
+				map[string]interface{}{"service": "transcribe", "limit": 1},
+				map[string]interface{}{"service": "transcribeservice", "limit": 2},
+			},
+			wantError: true,
+		},
+		"unsupported": {
+			tfList: []interface{}{
+				map[string]interface{}{"service": "notaservice", "limit": 1},
+			},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceConcurrency(ctx, testCase.tfList)
+
+			if got, want := diags.HasError(), testCase.wantError; got != want {
+				t.Fatalf("expandServiceConcurrency() error %t, want %t: %v", got, want, diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfList    []interface{}
+		wantKeys  []string
+		wantError bool
+	}{
+		"empty": {},
+		"tags": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"key":            "CostCenter",
+					"allowed_values": schema.NewSet(schema.HashString, []interface{}{`[0-9]{4}`}),
+					"resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+				map[string]interface{}{
+					"key":            "Owner",
+					"allowed_values": schema.NewSet(schema.HashString, []interface{}{}),
+					"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_instance"}),
+				},
+			},
+			wantKeys: []string{"CostCenter", "Owner"},
+		},
+		"invalid allowed value": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"key":            "CostCenter",
+					"allowed_values": schema.NewSet(schema.HashString, []interface{}{`[0-9`}),
+					"resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandRequiredTags(ctx, testCase.tfList)
+
+			if got, want := diags.HasError(), testCase.wantError; got != want {
+				t.Fatalf("expandRequiredTags() error %t, want %t: %v", got, want, diags)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			var gotKeys []string
+			for _, v := range got.Tags {
+				gotKeys = append(gotKeys, v.Key)
+			}
+
+			if diff := cmp.Diff(gotKeys, testCase.wantKeys); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := expandDefaultTags(ctx, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Environment": "prod",
+		},
+		"include_resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+		"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_autoscaling_group"}),
+		"scoped_tags": []interface{}{
+			map[string]interface{}{
+				"tags": map[string]interface{}{
+					"DataClassification": "confidential",
+				},
+				"include_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_s3_*", "aws_db_instance"}),
+				"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+			},
+		},
+	})
+
+	testCases := map[string]map[string]string{
+		"aws_instance": {
+			"Environment": "prod",
+		},
+		"aws_s3_bucket": {
+			"Environment":        "prod",
+			"DataClassification": "confidential",
+		},
+		"aws_autoscaling_group": {},
+	}
+
+	for resourceType, want := range testCases {
+		got := defaultConfig.ForResourceType(resourceType).MergeTags(tftags.New(ctx, map[string]string{})).Map()
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("%s: unexpected diff (+wanted, -got): %s", resourceType, diff)
+		}
+	}
+}
+
+func TestExpandIgnoreTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ignoreConfig, diags := expandIgnoreTags(ctx, map[string]interface{}{
+		"keys":         schema.NewSet(schema.HashString, []interface{}{"Owner"}),
+		"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"tf:"}),
+		"key_regexes":  schema.NewSet(schema.HashString, []interface{}{`kubernetes\.io/cluster/.+`}),
+		"tag": []interface{}{
+			map[string]interface{}{
+				"key_regex":   `backup:.*`,
+				"value_regex": `[0-9]{8}T[0-9]{6}Z`,
+			},
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("expandIgnoreTags() error: %v", diags)
+	}
+
+	got := tftags.New(ctx, map[string]string{
+		"Owner":                      "test",
+		"tf:key":                     "value",
+		"kubernetes.io/cluster/test": "owned",
+		"backup:last":                "20240301T120000Z",
+		"backup:policy":              "daily",
+		"Name":                       "test",
+	}).IgnoreConfig(ignoreConfig).Map()
+	want := map[string]string{
+		"backup:policy": "daily",
+		"Name":          "test",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, diags = expandIgnoreTags(ctx, map[string]interface{}{
+		"key_regexes": schema.NewSet(schema.HashString, []interface{}{`[0-9`}),
+	})
+
+	if !diags.HasError() {
+		t.Error("expandIgnoreTags() expected error")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
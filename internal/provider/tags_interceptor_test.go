@@ -22,6 +22,10 @@ func (t *mockService) FrameworkDataSources(ctx context.Context) []*types.Service
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (t *mockService) FrameworkFunctions(ctx context.Context) []*types.ServicePackageFrameworkFunction {
+	return []*types.ServicePackageFrameworkFunction{}
+}
+
 func (t *mockService) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{}
 }
@@ -74,6 +78,9 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		interceptor: tags,
 	})
 
+	ignoreTagsConfig, _ := expandIgnoreTags(context.Background(), map[string]interface{}{
+		"tag2": "tag",
+	})
 	conn := &conns.AWSClient{
 		ServicePackages: map[string]conns.ServicePackage{
 			"Test": &mockService{},
@@ -81,13 +88,11 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
 			"tag": "",
 		}),
-		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
-			"tag2": "tag",
-		}),
+		IgnoreTagsConfig: ignoreTagsConfig,
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 		}
@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 // Inspired by "github.com/ServiceWeaver/weaver/runtime/retry".
@@ -76,7 +78,12 @@ func randomizedSleep(ctx context.Context, d time.Duration) {
 }
 
 // Sleeps for the specified duration or until context is done, whichever occurs first.
+// No time is spent sleeping when replaying VCR cassettes.
 func sleep(ctx context.Context, d time.Duration) {
+	if vcr.IsReplaying(ctx) {
+		return
+	}
+
 	t := time.NewTimer(d)
 	defer t.Stop()
 	select {
@@ -603,7 +603,7 @@ func waitCertificateAuthorityCreated(ctx context.Context, conn *acmpca.ACMPCA, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*acmpca.CertificateAuthority); ok {
 		if status := aws.StringValue(output.Status); status == acmpca.CertificateAuthorityStatusFailed {
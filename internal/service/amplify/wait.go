@@ -27,7 +27,7 @@ func waitDomainAssociationCreated(ctx context.Context, conn *amplify.Amplify, ap
 		Timeout: domainAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
 		if status := aws.StringValue(v.DomainStatus); status == amplify.DomainStatusFailed {
@@ -48,7 +48,7 @@ func waitDomainAssociationVerified(ctx context.Context, conn *amplify.Amplify, a
 		Timeout: domainAssociationVerifiedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
 		if v != nil && aws.StringValue(v.DomainStatus) == amplify.DomainStatusFailed {
@@ -474,7 +474,7 @@ func waitDomainNameUpdated(ctx context.Context, conn *apigateway.APIGateway, dom
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigateway.DomainName); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.DomainNameStatusMessage)))
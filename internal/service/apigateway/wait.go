@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -34,7 +35,7 @@ func waitVPCLinkAvailable(ctx context.Context, conn *apigateway.APIGateway, vpcL
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -52,7 +53,7 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigateway.APIGateway, vpcLin
 		Refresh:    vpcLinkStatus(ctx, conn, vpcLinkId),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	return err
 }
@@ -69,7 +70,7 @@ func waitStageCacheAvailable(ctx context.Context, conn *apigateway.APIGateway, r
 		Timeout: stageCacheAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigateway.Stage); ok {
 		return output, err
@@ -94,7 +95,7 @@ func waitStageCacheUpdated(ctx context.Context, conn *apigateway.APIGateway, res
 		Timeout: stageCacheUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigateway.Stage); ok {
 		return output, err
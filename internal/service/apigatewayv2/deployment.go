@@ -225,7 +225,7 @@ func waitDeploymentDeployed(ctx context.Context, conn *apigatewayv2.ApiGatewayV2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetDeploymentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.DeploymentStatusMessage)))
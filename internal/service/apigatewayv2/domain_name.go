@@ -304,7 +304,7 @@ func waitDomainNameAvailable(ctx context.Context, conn *apigatewayv2.ApiGatewayV
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetDomainNameOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.DomainNameConfigurations[0].DomainNameStatusMessage)))
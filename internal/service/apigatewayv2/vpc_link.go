@@ -228,7 +228,7 @@ func waitVPCLinkAvailable(ctx context.Context, conn *apigatewayv2.ApiGatewayV2,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.VpcLinkStatusMessage)))
@@ -250,7 +250,7 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, vp
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.VpcLinkStatusMessage)))
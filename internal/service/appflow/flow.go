@@ -1473,7 +1473,7 @@ func waitFlowDeleted(ctx context.Context, conn *appflow.Client, arn string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FlowDefinition); ok {
 		return output, err
@@ -19,6 +19,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (p *servicePackage) FrameworkFunctions(ctx context.Context) []*types.ServicePackageFrameworkFunction {
+	return []*types.ServicePackageFrameworkFunction{}
+}
+
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{}
 }
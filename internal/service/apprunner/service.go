@@ -682,7 +682,7 @@ func waitServiceCreated(ctx context.Context, conn *apprunner.Client, arn string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Service); ok {
 		return output, err
@@ -702,7 +702,7 @@ func waitServiceUpdated(ctx context.Context, conn *apprunner.Client, arn string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Service); ok {
 		return output, err
@@ -722,7 +722,7 @@ func waitServiceDeleted(ctx context.Context, conn *apprunner.Client, arn string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Service); ok {
 		return output, err
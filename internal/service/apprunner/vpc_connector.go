@@ -221,7 +221,7 @@ func waitVPCConnectorCreated(ctx context.Context, conn *apprunner.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcConnector); ok {
 		return output, err
@@ -241,7 +241,7 @@ func waitVPCConnectorDeleted(ctx context.Context, conn *apprunner.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcConnector); ok {
 		return output, err
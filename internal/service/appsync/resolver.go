@@ -228,7 +228,9 @@ func resourceResolverCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	mutexKey := "appsync-schema-" + apiID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
@@ -358,7 +360,9 @@ func resourceResolverUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	mutexKey := "appsync-schema-" + apiID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
@@ -388,7 +392,9 @@ func resourceResolverDelete(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	mutexKey := "appsync-schema-" + apiID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,7 +27,7 @@ func waitAPICacheAvailable(ctx context.Context, conn *appsync.AppSync, id string
 		Timeout: apiCacheAvailableTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -39,7 +40,7 @@ func waitAPICacheDeleted(ctx context.Context, conn *appsync.AppSync, id string)
 		Timeout: apiCacheDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -52,7 +53,7 @@ func waitDomainNameAPIAssociation(ctx context.Context, conn *appsync.AppSync, id
 		Timeout: domainNameAPIAssociationTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -65,7 +66,7 @@ func waitDomainNameAPIDisassociation(ctx context.Context, conn *appsync.AppSync,
 		Timeout: domainNameAPIDisassociationTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
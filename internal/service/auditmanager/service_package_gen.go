@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 	}
 }
 
+func (p *servicePackage) FrameworkFunctions(ctx context.Context) []*types.ServicePackageFrameworkFunction {
+	return []*types.ServicePackageFrameworkFunction{}
+}
+
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
 		{
@@ -2580,7 +2580,7 @@ func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.AutoScali
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(struct{ err error }); ok {
 		tfresource.SetLastError(err, output.err)
@@ -2596,7 +2596,7 @@ func waitGroupDrained(ctx context.Context, conn *autoscaling.AutoScaling, name s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.Group); ok {
 		return output, err
@@ -2612,7 +2612,7 @@ func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.AutoScaling,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerState); ok {
 		return output, err
@@ -2628,7 +2628,7 @@ func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.AutoScaling
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerState); ok {
 		return output, err
@@ -2644,7 +2644,7 @@ func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Au
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2660,7 +2660,7 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2676,7 +2676,7 @@ func waitTrafficSourcesCreated(ctx context.Context, conn *autoscaling.AutoScalin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.TrafficSourceState); ok {
 		return output, err
@@ -2692,7 +2692,7 @@ func waitTrafficSourcesDeleted(ctx context.Context, conn *autoscaling.AutoScalin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.TrafficSourceState); ok {
 		return output, err
@@ -2727,7 +2727,7 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoSca
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
 		return output, err
@@ -2744,7 +2744,7 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.WarmPoolConfiguration); ok {
 		return output, err
@@ -2760,7 +2760,7 @@ func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.AutoScaling, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.DescribeWarmPoolOutput); ok {
 		return output, err
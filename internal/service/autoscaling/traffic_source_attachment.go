@@ -209,7 +209,7 @@ func waitTrafficSourceAttachmentCreated(ctx context.Context, conn *autoscaling.A
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.TrafficSourceState); ok {
 		return output, err
@@ -226,7 +226,7 @@ func waitTrafficSourceAttachmentDeleted(ctx context.Context, conn *autoscaling.A
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.TrafficSourceState); ok {
 		return output, err
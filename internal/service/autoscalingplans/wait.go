@@ -29,7 +29,7 @@ func waitScalingPlanCreated(ctx context.Context, conn *autoscalingplans.AutoScal
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeCreationFailed {
@@ -51,7 +51,7 @@ func waitScalingPlanDeleted(ctx context.Context, conn *autoscalingplans.AutoScal
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeDeletionFailed {
@@ -73,7 +73,7 @@ func waitScalingPlanUpdated(ctx context.Context, conn *autoscalingplans.AutoScal
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeUpdateFailed {
@@ -420,7 +420,7 @@ func waitReportPlanCreated(ctx context.Context, conn *backup.Backup, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.ReportPlan); ok {
 		return output, err
@@ -437,7 +437,7 @@ func waitReportPlanDeleted(ctx context.Context, conn *backup.Backup, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.ReportPlan); ok {
 		return output, err
@@ -454,7 +454,7 @@ func waitReportPlanUpdated(ctx context.Context, conn *backup.Backup, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.ReportPlan); ok {
 		return output, err
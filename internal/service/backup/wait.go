@@ -27,7 +27,7 @@ func WaitJobCompleted(ctx context.Context, conn *backup.Backup, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeBackupJobOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -46,7 +46,7 @@ func waitFrameworkCreated(ctx context.Context, conn *backup.Backup, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -63,7 +63,7 @@ func waitFrameworkUpdated(ctx context.Context, conn *backup.Backup, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -80,7 +80,7 @@ func waitFrameworkDeleted(ctx context.Context, conn *backup.Backup, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -97,7 +97,7 @@ func waitRecoveryPointDeleted(ctx context.Context, conn *backup.Backup, backupVa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeRecoveryPointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
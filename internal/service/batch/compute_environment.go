@@ -741,7 +741,7 @@ func waitComputeEnvironmentCreated(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -762,7 +762,7 @@ func waitComputeEnvironmentDeleted(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -783,7 +783,7 @@ func waitComputeEnvironmentDisabled(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -804,7 +804,7 @@ func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		return err
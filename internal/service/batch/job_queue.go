@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -427,7 +428,7 @@ func disableJobQueue(ctx context.Context, conn *batch.Batch, id string, timeout
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err = stateChangeConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateChangeConf)
 	return err
 }
 
@@ -441,7 +442,7 @@ func waitJobQueueCreated(ctx context.Context, conn *batch.Batch, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.JobQueueDetail); ok {
 		return output, err
@@ -460,7 +461,7 @@ func waitJobQueueUpdated(ctx context.Context, conn *batch.Batch, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.JobQueueDetail); ok {
 		return output, err
@@ -479,7 +480,7 @@ func waitJobQueueDeleted(ctx context.Context, conn *batch.Batch, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.JobQueueDetail); ok {
 		return output, err
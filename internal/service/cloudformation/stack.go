@@ -477,7 +477,7 @@ func WaitStackCreated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -539,7 +539,7 @@ func WaitStackUpdated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -580,7 +580,7 @@ func WaitStackDeleted(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +257,7 @@ func waitUserPoolDomainCreated(ctx context.Context, conn *cognitoidentityprovide
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cognitoidentityprovider.DomainDescriptionType); ok {
 		return output, err
@@ -274,7 +274,7 @@ func waitUserPoolDomainUpdated(ctx context.Context, conn *cognitoidentityprovide
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cognitoidentityprovider.DomainDescriptionType); ok {
 		return output, err
@@ -291,7 +291,7 @@ func waitUserPoolDomainDeleted(ctx context.Context, conn *cognitoidentityprovide
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cognitoidentityprovider.DomainDescriptionType); ok {
 		return output, err
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -397,7 +398,7 @@ func waitForConformancePackStateCreateComplete(ctx context.Context, conn *config
 		Refresh: refreshConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
@@ -414,7 +415,7 @@ func waitForConformancePackStateDeleteComplete(ctx context.Context, conn *config
 		Refresh: refreshConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
@@ -433,7 +434,7 @@ func waitForOrganizationConformancePackStatusCreateSuccessful(ctx context.Contex
 		Delay: 30 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	return err
 }
@@ -446,7 +447,7 @@ func waitForOrganizationConformancePackStatusUpdateSuccessful(ctx context.Contex
 		Refresh: refreshOrganizationConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	return err
 }
@@ -459,7 +460,7 @@ func waitForOrganizationConformancePackStatusDeleteSuccessful(ctx context.Contex
 		Refresh: refreshOrganizationConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	return err
 }
@@ -474,7 +475,7 @@ func waitForOrganizationRuleStatusCreateSuccessful(ctx context.Context, conn *co
 		Delay:          30 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	return err
 }
@@ -488,7 +489,7 @@ func waitForOrganizationRuleStatusDeleteSuccessful(ctx context.Context, conn *co
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException) {
 		return nil
@@ -506,7 +507,7 @@ func waitForOrganizationRuleStatusUpdateSuccessful(ctx context.Context, conn *co
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	return err
 }
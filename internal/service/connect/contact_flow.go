@@ -118,7 +118,9 @@ func resourceContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 		// Grab an exclusive lock so that we're only reading one contact flow into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(contactFlowMutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, contactFlowMutexKey); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(contactFlowMutexKey)
 		file, err := resourceContactFlowLoadFileContent(filename)
 		if err != nil {
@@ -224,7 +226,9 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 			// Grab an exclusive lock so that we're only reading one contact flow into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(contactFlowMutexKey)
+			if err := conns.GlobalMutexKV.LockContext(ctx, contactFlowMutexKey); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 			defer conns.GlobalMutexKV.Unlock(contactFlowMutexKey)
 			file, err := resourceContactFlowLoadFileContent(filename)
 			if err != nil {
@@ -112,7 +112,9 @@ func resourceContactFlowModuleCreate(ctx context.Context, d *schema.ResourceData
 		// Grab an exclusive lock so that we're only reading one contact flow module into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(contactFlowModuleMutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, contactFlowModuleMutexKey); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(contactFlowModuleMutexKey)
 		file, err := resourceContactFlowModuleLoadFileContent(filename)
 		if err != nil {
@@ -217,7 +219,9 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 			// Grab an exclusive lock so that we're only reading one contact flow module into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(contactFlowModuleMutexKey)
+			if err := conns.GlobalMutexKV.LockContext(ctx, contactFlowModuleMutexKey); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 			defer conns.GlobalMutexKV.Unlock(contactFlowModuleMutexKey)
 			file, err := resourceContactFlowModuleLoadFileContent(filename)
 			if err != nil {
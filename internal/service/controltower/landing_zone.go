@@ -304,7 +304,7 @@ func waitLandingZoneOperationSucceeded(ctx context.Context, conn *controltower.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LandingZoneOperationDetail); ok {
 		if status := output.Status; status == types.LandingZoneOperationStatusFailed {
@@ -293,7 +293,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
 	}
@@ -458,7 +458,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:      30 * time.Second,
 		}
 
-		_, sterr := stateConf.WaitForStateContext(ctx)
+		_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 		if sterr != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to update: %s", d.Id(), sterr)
 		}
@@ -532,7 +532,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      30 * time.Second,
 	}
 
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to delete: %s", d.Id(), sterr)
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKResource("aws_dx_bgp_peer")
@@ -129,7 +130,7 @@ func resourceBGPPeerCreate(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect BGP peer (%s) to be available: %s", d.Id(), err)
 	}
@@ -203,7 +204,7 @@ func resourceBGPPeerDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Direct Connect BGP Peer (%s): waiting for completion: %s", d.Id(), err)
 	}
@@ -30,7 +30,7 @@ func waitConnectionConfirmed(ctx context.Context, conn *directconnect.DirectConn
 		Timeout: connectionConfirmedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -47,7 +47,7 @@ func waitConnectionDeleted(ctx context.Context, conn *directconnect.DirectConnec
 		Timeout: connectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -64,7 +64,7 @@ func waitGatewayCreated(ctx context.Context, conn *directconnect.DirectConnect,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Gateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -83,7 +83,7 @@ func waitGatewayDeleted(ctx context.Context, conn *directconnect.DirectConnect,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Gateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -102,7 +102,7 @@ func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.Dire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -121,7 +121,7 @@ func waitGatewayAssociationUpdated(ctx context.Context, conn *directconnect.Dire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -140,7 +140,7 @@ func waitGatewayAssociationDeleted(ctx context.Context, conn *directconnect.Dire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -159,7 +159,7 @@ func waitHostedConnectionDeleted(ctx context.Context, conn *directconnect.Direct
 		Timeout: hostedConnectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -176,7 +176,7 @@ func waitLagDeleted(ctx context.Context, conn *directconnect.DirectConnect, id s
 		Timeout: lagDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Lag); ok {
 		return output, err
@@ -287,7 +287,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *dms.DatabaseMigrati
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.EventSubscription); ok {
 		return output, err
@@ -306,7 +306,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *dms.DatabaseMigrati
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.EventSubscription); ok {
 		return output, err
@@ -325,7 +325,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *dms.DatabaseMigrati
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.EventSubscription); ok {
 		return output, err
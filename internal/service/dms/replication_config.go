@@ -499,7 +499,7 @@ func waitReplicationRunning(ctx context.Context, conn *dms.DatabaseMigrationServ
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.Replication); ok {
 		setLastReplicationError(err, output)
@@ -519,7 +519,7 @@ func waitReplicationStopped(ctx context.Context, conn *dms.DatabaseMigrationServ
 		Delay:      60 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.Replication); ok {
 		setLastReplicationError(err, output)
@@ -539,7 +539,7 @@ func waitReplicationDeleted(ctx context.Context, conn *dms.DatabaseMigrationServ
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.Replication); ok {
 		setLastReplicationError(err, output)
@@ -261,7 +261,7 @@ func waitClusterSnapshotCreated(ctx context.Context, conn *docdb.DocDB, id strin
 		Delay:      5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.DBClusterSnapshot); ok {
 		return output, err
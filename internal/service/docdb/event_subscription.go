@@ -364,7 +364,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *docdb.DocDB, name s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.EventSubscription); ok {
 		return output, err
@@ -383,7 +383,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *docdb.DocDB, name s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.EventSubscription); ok {
 		return output, err
@@ -402,7 +402,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *docdb.DocDB, name s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.EventSubscription); ok {
 		return output, err
@@ -230,7 +230,7 @@ func waitGlobalTableCreated(ctx context.Context, conn *dynamodb.DynamoDB, name s
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalTableDescription); ok {
 		return output, err
@@ -248,7 +248,7 @@ func waitGlobalTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, name s
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalTableDescription); ok {
 		return output, err
@@ -266,7 +266,7 @@ func waitGlobalTableUpdated(ctx context.Context, conn *dynamodb.DynamoDB, name s
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalTableDescription); ok {
 		return output, err
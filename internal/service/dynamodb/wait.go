@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -40,7 +41,7 @@ func waitTableActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 		Refresh: statusTable(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -57,7 +58,7 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, importArn
 		Refresh: statusImport(ctx, conn, importArn),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		err = fmt.Errorf("ImportArn %q : %w", importArn, err)
@@ -78,7 +79,7 @@ func waitTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName st
 		Refresh: statusTable(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -95,7 +96,7 @@ func waitReplicaActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName,
 		Refresh: statusReplicaUpdate(ctx, conn, tableName, region),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -113,7 +114,7 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName,
 		Refresh: statusReplicaDelete(ctx, conn, tableName, region),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -126,7 +127,7 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName, inde
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -143,7 +144,7 @@ func waitGSIDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName, ind
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -172,7 +173,7 @@ func waitPITRUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 		MinTimeout: 15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -197,7 +198,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		Refresh: statusTTL(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TimeToLiveDescription); ok {
 		return output, err
@@ -215,7 +216,7 @@ func waitSSEUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		Refresh: statusTableSES(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -239,7 +240,7 @@ func waitReplicaSSEUpdated(ctx context.Context, client *conns.AWSClient, region
 		Refresh: statusTableSES(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -256,7 +257,7 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -269,7 +270,7 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -228,7 +228,7 @@ func waitEBSFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
 		return out, err
 	}
@@ -244,7 +244,7 @@ func waitEBSFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
 		return out, err
 	}
@@ -3081,7 +3081,7 @@ func waitInstanceCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3110,7 +3110,7 @@ func waitInstanceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3133,7 +3133,7 @@ func waitInstanceReady(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3156,7 +3156,7 @@ func waitInstanceStarted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3184,7 +3184,7 @@ func waitInstanceStopped(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
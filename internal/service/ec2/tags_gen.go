@@ -179,3 +179,13 @@ func updateTags(ctx context.Context, conn ec2iface.EC2API, identifier string, ol
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
 	return updateTags(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), identifier, oldTags, newTags)
 }
+
+// TagConstraints returns the ec2 service's constraints on the tags of a single resource.
+func (p *servicePackage) TagConstraints() *tftags.Constraints {
+	return &tftags.Constraints{
+		MaxCount:          50,
+		MaxKeyLength:      128,
+		MaxValueLength:    256,
+		ReservedKeyPrefix: "aws:",
+	}
+}
@@ -70,7 +70,9 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 	// See https://github.com/hashicorp/terraform-provider-aws/issues/3382.
 	// Prevent concurrent subnet association requests and delay between requests.
 	mk := "vpc_endpoint_subnet_association_" + endpointID
-	conns.GlobalMutexKV.Lock(mk)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mk); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mk)
 
 	c := &retry.StateChangeConf{
@@ -83,7 +85,7 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 			return output, "ok", err
 		},
 	}
-	_, err := c.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, c)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating VPC Endpoint Subnet Association (%s): %s", id, err)
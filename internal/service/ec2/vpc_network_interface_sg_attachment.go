@@ -59,7 +59,9 @@ func resourceNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.R
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
 	mutexKey := "network_interface_sg_attachment_" + networkInterfaceID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
@@ -144,7 +146,9 @@ func resourceNetworkInterfaceSGAttachmentDelete(ctx context.Context, d *schema.R
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
 	mutexKey := "network_interface_sg_attachment_" + networkInterfaceID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
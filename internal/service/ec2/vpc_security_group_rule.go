@@ -158,7 +158,9 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -329,7 +331,9 @@ func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChange("description") {
 		securityGroupID := d.Get("security_group_id").(string)
 
-		conns.GlobalMutexKV.Lock(securityGroupID)
+		if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 		sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -373,7 +377,9 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -56,7 +56,7 @@ func WaitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.EC2, name s
 		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AvailabilityZone); ok {
 		return output, err
@@ -73,7 +73,7 @@ func WaitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.EC2, nam
 		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AvailabilityZone); ok {
 		return output, err
@@ -95,7 +95,7 @@ func WaitCapacityReservationActive(ctx context.Context, conn *ec2.EC2, id string
 		Timeout: CapacityReservationActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
 		return output, err
@@ -112,7 +112,7 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 		Timeout: CapacityReservationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
 		return output, err
@@ -135,7 +135,7 @@ func WaitCarrierGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*
 		Timeout: CarrierGatewayAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -152,7 +152,7 @@ func WaitCarrierGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*
 		Timeout: CarrierGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -178,7 +178,7 @@ func WaitLocalGatewayRouteTableVPCAssociationAssociated(ctx context.Context, con
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -196,7 +196,7 @@ func WaitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context,
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -218,7 +218,7 @@ func WaitClientVPNEndpointDeleted(ctx context.Context, conn *ec2.EC2, id string)
 		Timeout: ClientVPNEndpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -237,7 +237,7 @@ func WaitClientVPNEndpointClientConnectResponseOptionsUpdated(ctx context.Contex
 		Timeout: ClientVPNEndpointAttributeUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientConnectResponseOptions); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -261,7 +261,7 @@ func WaitClientVPNAuthorizationRuleCreated(ctx context.Context, conn *ec2.EC2, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -280,7 +280,7 @@ func WaitClientVPNAuthorizationRuleDeleted(ctx context.Context, conn *ec2.EC2, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -309,7 +309,7 @@ func WaitClientVPNNetworkAssociationCreated(ctx context.Context, conn *ec2.EC2,
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -330,7 +330,7 @@ func WaitClientVPNNetworkAssociationDeleted(ctx context.Context, conn *ec2.EC2,
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -349,7 +349,7 @@ func WaitClientVPNRouteCreated(ctx context.Context, conn *ec2.EC2, endpointID, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -368,7 +368,7 @@ func WaitClientVPNRouteDeleted(ctx context.Context, conn *ec2.EC2, endpointID, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -389,7 +389,7 @@ func WaitFleet(ctx context.Context, conn *ec2.EC2, id string, pending, target []
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.FleetData); ok {
 		return output, err
@@ -408,7 +408,7 @@ func WaitImageAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout t
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -431,7 +431,7 @@ func WaitImageDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout tim
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -453,7 +453,7 @@ func WaitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.EC2, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -471,7 +471,7 @@ func WaitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, co
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -489,7 +489,7 @@ func WaitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InstanceMaintenanceOptions); ok {
 		return output, err
@@ -508,7 +508,7 @@ func WaitInstanceMetadataOptionsApplied(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InstanceMetadataOptionsResponse); ok {
 		return output, err
@@ -526,7 +526,7 @@ func WaitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.EbsInstanceBlockDevice); ok {
 		return output, err
@@ -546,7 +546,7 @@ func WaitRouteDeleted(ctx context.Context, conn *ec2.EC2, routeFinder RouteFinde
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -565,7 +565,7 @@ func WaitRouteReady(ctx context.Context, conn *ec2.EC2, routeFinder RouteFinder,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -590,7 +590,7 @@ func WaitRouteTableReady(ctx context.Context, conn *ec2.EC2, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -608,7 +608,7 @@ func WaitRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string, timeou
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -626,7 +626,7 @@ func WaitRouteTableAssociationCreated(ctx context.Context, conn *ec2.EC2, id str
 		NotFoundChecks: RouteTableAssociationCreatedNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -647,7 +647,7 @@ func WaitRouteTableAssociationDeleted(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -668,7 +668,7 @@ func WaitRouteTableAssociationUpdated(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -691,7 +691,7 @@ func WaitSecurityGroupCreated(ctx context.Context, conn *ec2.EC2, id string, tim
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SecurityGroup); ok {
 		return output, err
@@ -713,7 +713,7 @@ func WaitSubnetAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -730,7 +730,7 @@ func WaitSubnetIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC
 		Timeout: SubnetIPv6CIDRBlockAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SubnetCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.SubnetCidrBlockStateCodeFailed {
@@ -751,7 +751,7 @@ func WaitSubnetIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC
 		Timeout: SubnetIPv6CIDRBlockAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SubnetCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.SubnetCidrBlockStateCodeFailed {
@@ -773,7 +773,7 @@ func waitSubnetAssignIPv6AddressOnCreationUpdated(ctx context.Context, conn *ec2
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -791,7 +791,7 @@ func waitSubnetEnableLniAtDeviceIndexUpdated(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -809,7 +809,7 @@ func waitSubnetEnableDNS64Updated(ctx context.Context, conn *ec2.EC2, subnetID s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -827,7 +827,7 @@ func waitSubnetEnableResourceNameDNSAAAARecordOnLaunchUpdated(ctx context.Contex
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -845,7 +845,7 @@ func waitSubnetEnableResourceNameDNSARecordOnLaunchUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -863,7 +863,7 @@ func WaitSubnetMapCustomerOwnedIPOnLaunchUpdated(ctx context.Context, conn *ec2.
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -881,7 +881,7 @@ func WaitSubnetMapPublicIPOnLaunchUpdated(ctx context.Context, conn *ec2.EC2, su
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -899,7 +899,7 @@ func WaitSubnetPrivateDNSHostnameTypeOnLaunchUpdated(ctx context.Context, conn *
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -920,7 +920,7 @@ func WaitTransitGatewayCreated(ctx context.Context, conn *ec2.EC2, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -938,7 +938,7 @@ func WaitTransitGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string, ti
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -955,7 +955,7 @@ func WaitTransitGatewayUpdated(ctx context.Context, conn *ec2.EC2, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -972,7 +972,7 @@ func WaitTransitGatewayConnectCreated(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
 		return output, err
@@ -990,7 +990,7 @@ func WaitTransitGatewayConnectDeleted(ctx context.Context, conn *ec2.EC2, id str
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
 		return output, err
@@ -1007,7 +1007,7 @@ func WaitTransitGatewayConnectPeerCreated(ctx context.Context, conn *ec2.EC2, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -1024,7 +1024,7 @@ func WaitTransitGatewayConnectPeerDeleted(ctx context.Context, conn *ec2.EC2, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -1041,7 +1041,7 @@ func WaitTransitGatewayMulticastDomainCreated(ctx context.Context, conn *ec2.EC2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -1058,7 +1058,7 @@ func WaitTransitGatewayMulticastDomainDeleted(ctx context.Context, conn *ec2.EC2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -1075,7 +1075,7 @@ func WaitTransitGatewayMulticastDomainAssociationCreated(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -1092,7 +1092,7 @@ func WaitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -1115,7 +1115,7 @@ func WaitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1136,7 +1136,7 @@ func WaitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.E
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1162,7 +1162,7 @@ func WaitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.E
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1187,7 +1187,7 @@ func WaitTransitGatewayPrefixListReferenceStateCreated(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1204,7 +1204,7 @@ func WaitTransitGatewayPrefixListReferenceStateDeleted(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1221,7 +1221,7 @@ func WaitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1243,7 +1243,7 @@ func WaitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayStaticRouteState(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
 		return output, err
@@ -1260,7 +1260,7 @@ func WaitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayStaticRouteState(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
 		return output, err
@@ -1284,7 +1284,7 @@ func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayPolicyTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -1301,7 +1301,7 @@ func WaitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTable); ok {
 		return output, err
@@ -1318,7 +1318,7 @@ func WaitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayPolicyTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -1335,7 +1335,7 @@ func WaitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTable); ok {
 		return output, err
@@ -1359,7 +1359,7 @@ func WaitTransitGatewayPolicyTableAssociationCreated(ctx context.Context, conn *
 		Refresh: StatusTransitGatewayPolicyTableAssociationState(ctx, conn, transitGatewayPolicyTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -1377,7 +1377,7 @@ func WaitTransitGatewayPolicyTableAssociationDeleted(ctx context.Context, conn *
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -1394,7 +1394,7 @@ func WaitTransitGatewayRouteTableAssociationCreated(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTableAssociationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAssociation); ok {
 		return output, err
@@ -1412,7 +1412,7 @@ func WaitTransitGatewayRouteTableAssociationDeleted(ctx context.Context, conn *e
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAssociation); ok {
 		return output, err
@@ -1434,7 +1434,7 @@ func WaitTransitGatewayRouteTablePropagationCreated(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTablePropagationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTablePropagation); ok {
 		return output, err
@@ -1451,7 +1451,7 @@ func WaitTransitGatewayRouteTablePropagationDeleted(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTablePropagationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
 		return nil, nil
@@ -1478,7 +1478,7 @@ func WaitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1495,7 +1495,7 @@ func WaitTransitGatewayVPCAttachmentCreated(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1517,7 +1517,7 @@ func WaitTransitGatewayVPCAttachmentDeleted(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1534,7 +1534,7 @@ func WaitTransitGatewayVPCAttachmentUpdated(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1553,7 +1553,7 @@ func WaitVolumeCreated(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Volume); ok {
 		return output, err
@@ -1572,7 +1572,7 @@ func WaitVolumeDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Volume); ok {
 		return output, err
@@ -1591,7 +1591,7 @@ func WaitVolumeUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Volume); ok {
 		return output, err
@@ -1610,7 +1610,7 @@ func WaitVolumeAttachmentCreated(ctx context.Context, conn *ec2.EC2, volumeID, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeAttachment); ok {
 		return output, err
@@ -1629,7 +1629,7 @@ func WaitVolumeAttachmentDeleted(ctx context.Context, conn *ec2.EC2, volumeID, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeAttachment); ok {
 		return output, err
@@ -1651,7 +1651,7 @@ func WaitVolumeModificationComplete(ctx context.Context, conn *ec2.EC2, id strin
 		MinTimeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeModification); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -1677,7 +1677,7 @@ func WaitVPCCIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1700,7 +1700,7 @@ func WaitVPCCIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1728,7 +1728,7 @@ func WaitVPCIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1751,7 +1751,7 @@ func WaitVPCIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1772,7 +1772,7 @@ func WaitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.EC2, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1795,7 +1795,7 @@ func WaitVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.EC2, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1821,7 +1821,7 @@ func WaitVPNGatewayVPCAttachmentAttached(ctx context.Context, conn *ec2.EC2, vpn
 		Timeout: VPNGatewayVPCAttachmentAttachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -1838,7 +1838,7 @@ func WaitVPNGatewayVPCAttachmentDetached(ctx context.Context, conn *ec2.EC2, vpn
 		Timeout: VPNGatewayVPCAttachmentDetachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -1862,7 +1862,7 @@ func WaitCustomerGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CustomerGateway); ok {
 		return output, err
@@ -1879,7 +1879,7 @@ func WaitCustomerGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (
 		Timeout: customerGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CustomerGateway); ok {
 		return output, err
@@ -1896,7 +1896,7 @@ func WaitNATGatewayCreated(ctx context.Context, conn *ec2.EC2, id string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGateway); ok {
 		if state := aws.StringValue(output.State); state == ec2.NatGatewayStateFailed {
@@ -1919,7 +1919,7 @@ func WaitNATGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGateway); ok {
 		if state := aws.StringValue(output.State); state == ec2.NatGatewayStateFailed {
@@ -1940,7 +1940,7 @@ func WaitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.EC2, natGatewa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -1961,7 +1961,7 @@ func WaitNATGatewayAddressAssociated(ctx context.Context, conn *ec2.EC2, natGate
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -1982,7 +1982,7 @@ func WaitNATGatewayAddressDisassociated(ctx context.Context, conn *ec2.EC2, natG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -2003,7 +2003,7 @@ func WaitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.EC2, natGate
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -2032,7 +2032,7 @@ func WaitVPNConnectionCreated(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2051,7 +2051,7 @@ func WaitVPNConnectionDeleted(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2070,7 +2070,7 @@ func WaitVPNConnectionUpdated(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2092,7 +2092,7 @@ func WaitVPNConnectionRouteCreated(ctx context.Context, conn *ec2.EC2, vpnConnec
 		Timeout: vpnConnectionRouteCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnStaticRoute); ok {
 		return output, err
@@ -2109,7 +2109,7 @@ func WaitVPNConnectionRouteDeleted(ctx context.Context, conn *ec2.EC2, vpnConnec
 		Timeout: vpnConnectionRouteDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnStaticRoute); ok {
 		return output, err
@@ -2133,7 +2133,7 @@ func WaitVPNGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnGateway); ok {
 		return output, err
@@ -2152,7 +2152,7 @@ func WaitVPNGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnGateway); ok {
 		return output, err
@@ -2175,7 +2175,7 @@ func WaitHostCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host,
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2192,7 +2192,7 @@ func WaitHostUpdated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host,
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2209,7 +2209,7 @@ func WaitHostDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host,
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2231,7 +2231,7 @@ func WaitInternetGatewayAttached(ctx context.Context, conn *ec2.EC2, internetGat
 		Refresh:        StatusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InternetGatewayAttachment); ok {
 		return output, err
@@ -2248,7 +2248,7 @@ func WaitInternetGatewayDetached(ctx context.Context, conn *ec2.EC2, internetGat
 		Refresh: StatusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InternetGatewayAttachment); ok {
 		return output, err
@@ -2269,7 +2269,7 @@ func WaitManagedPrefixListCreated(ctx context.Context, conn *ec2.EC2, id string)
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateCreateFailed {
@@ -2290,7 +2290,7 @@ func WaitManagedPrefixListModified(ctx context.Context, conn *ec2.EC2, id string
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateModifyFailed {
@@ -2311,7 +2311,7 @@ func WaitManagedPrefixListDeleted(ctx context.Context, conn *ec2.EC2, id string)
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateDeleteFailed {
@@ -2334,7 +2334,7 @@ func WaitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -2358,7 +2358,7 @@ func WaitNetworkInterfaceAttached(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceAttachmentStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -2382,7 +2382,7 @@ func WaitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.EC2, i
 		NotFoundChecks:            1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
 		return output, err
@@ -2399,7 +2399,7 @@ func WaitNetworkInterfaceCreated(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
 		return output, err
@@ -2416,7 +2416,7 @@ func WaitNetworkInterfaceDetached(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceAttachmentStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -2438,7 +2438,7 @@ func WaitPlacementGroupCreated(ctx context.Context, conn *ec2.EC2, name string)
 		Refresh: StatusPlacementGroupState(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -2455,7 +2455,7 @@ func WaitPlacementGroupDeleted(ctx context.Context, conn *ec2.EC2, name string)
 		Refresh: StatusPlacementGroupState(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -2474,7 +2474,7 @@ func WaitSpotFleetRequestCreated(ctx context.Context, conn *ec2.EC2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		return output, err
@@ -2493,7 +2493,7 @@ func WaitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.EC2, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		if activityStatus := aws.StringValue(output.ActivityStatus); activityStatus == ec2.ActivityStatusError {
@@ -2531,7 +2531,7 @@ func WaitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.EC2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		return output, err
@@ -2550,7 +2550,7 @@ func WaitSpotInstanceRequestFulfilled(ctx context.Context, conn *ec2.EC2, id str
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotInstanceRequest); ok {
 		if fault := output.Fault; fault != nil {
@@ -2576,7 +2576,7 @@ func WaitVPCEndpointAccepted(ctx context.Context, conn *ec2.EC2, vpcEndpointID s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == vpcEndpointStateFailed && lastError != nil {
@@ -2599,7 +2599,7 @@ func WaitVPCEndpointAvailable(ctx context.Context, conn *ec2.EC2, vpcEndpointID
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == vpcEndpointStateFailed && lastError != nil {
@@ -2622,7 +2622,7 @@ func WaitVPCEndpointDeleted(ctx context.Context, conn *ec2.EC2, vpcEndpointID st
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		return output, err
@@ -2641,7 +2641,7 @@ func WaitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.EC2, id stri
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
 		return output, err
@@ -2660,7 +2660,7 @@ func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
 		return output, err
@@ -2678,7 +2678,7 @@ func WaitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2692,7 +2692,7 @@ func WaitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.EC
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2712,7 +2712,7 @@ func WaitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.EC2, importTas
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SnapshotTaskDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -2733,7 +2733,7 @@ func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.EC2, servi
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpointConnection); ok {
 		return output, err
@@ -2755,7 +2755,7 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.EC2, id string, t
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SnapshotTierStatus); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.LastTieringOperationStatus), aws.StringValue(output.LastTieringOperationStatusDetail)))
@@ -2775,7 +2775,7 @@ func WaitIPAMCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2793,7 +2793,7 @@ func WaitIPAMDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2811,7 +2811,7 @@ func WaitIPAMUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2829,7 +2829,7 @@ func WaitIPAMPoolCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateCreateFailed {
@@ -2851,7 +2851,7 @@ func WaitIPAMPoolDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateDeleteFailed {
@@ -2873,7 +2873,7 @@ func WaitIPAMPoolUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateModifyFailed {
@@ -2896,7 +2896,7 @@ func WaitIPAMPoolCIDRIdCreated(ctx context.Context, conn *ec2.EC2, poolCidrId, p
 		NotFoundChecks: IPAMPoolCIDRNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
 		if state, failureReason := aws.StringValue(output.State), output.FailureReason; state == ec2.IpamPoolCidrStateFailedProvision && failureReason != nil {
@@ -2918,7 +2918,7 @@ func WaitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.EC2, cidrBlock, pool
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
 		if state, failureReason := aws.StringValue(output.State), output.FailureReason; state == ec2.IpamPoolCidrStateFailedDeprovision && failureReason != nil {
@@ -2940,7 +2940,7 @@ func WaitIPAMPoolCIDRAllocationCreated(ctx context.Context, conn *ec2.EC2, alloc
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolAllocation); ok {
 		return output, err
@@ -2958,7 +2958,7 @@ func WaitIPAMResourceDiscoveryAvailable(ctx context.Context, conn *ec2.EC2, id s
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2976,7 +2976,7 @@ func WaitIPAMResourceDiscoveryAssociationAvailable(ctx context.Context, conn *ec
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscoveryAssociation); ok {
 		return output, err
@@ -2994,7 +2994,7 @@ func WaitIPAMResourceDiscoveryAssociationDeleted(ctx context.Context, conn *ec2.
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscoveryAssociation); ok {
 		return output, err
@@ -3012,7 +3012,7 @@ func WaitIPAMResourceDiscoveryDeleted(ctx context.Context, conn *ec2.EC2, id str
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscovery); ok {
 		return output, err
@@ -3030,7 +3030,7 @@ func WaitIPAMResourceDiscoveryUpdated(ctx context.Context, conn *ec2.EC2, id str
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscovery); ok {
 		return output, err
@@ -3048,7 +3048,7 @@ func WaitIPAMScopeCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3066,7 +3066,7 @@ func WaitIPAMScopeDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3084,7 +3084,7 @@ func WaitIPAMScopeUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3101,7 +3101,7 @@ func WaitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Ec2InstanceConnectEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.StateMessage)))
@@ -3120,7 +3120,7 @@ func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Ec2InstanceConnectEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.StateMessage)))
@@ -3138,7 +3138,7 @@ func WaitImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -3153,7 +3153,7 @@ func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
@@ -3174,7 +3174,7 @@ func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2_sdkv2.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
@@ -3193,7 +3193,7 @@ func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
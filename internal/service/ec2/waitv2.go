@@ -25,7 +25,7 @@ func waitVPCCreatedV2(ctx context.Context, conn *ec2.Client, id string) (*types.
 		Timeout: vpcCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Vpc); ok {
 		return output, err
@@ -44,7 +44,7 @@ func waitVPCIPv6CIDRBlockAssociationCreatedV2(ctx context.Context, conn *ec2.Cli
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcCidrBlockState); ok {
 		if state := output.State; state == types.VpcCidrBlockStateCodeFailed {
@@ -66,7 +66,7 @@ func waitVPCAttributeUpdatedV2(ctx context.Context, conn *ec2.Client, vpcID stri
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Vpc); ok {
 		return output, err
@@ -85,7 +85,7 @@ func waitVPCIPv6CIDRBlockAssociationDeletedV2(ctx context.Context, conn *ec2.Cli
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcCidrBlockState); ok {
 		if state := output.State; state == types.VpcCidrBlockStateCodeFailed {
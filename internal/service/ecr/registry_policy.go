@@ -37,10 +37,7 @@ func ResourceRegistryPolicy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				ValidateFunc:          validation.StringIsJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -93,13 +90,7 @@ func resourceRegistryPolicyRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("registry_id", out.RegistryId)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(out.PolicyText))
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading ECR Registry Policy (%s): setting policy: %s", d.Id(), err)
-	}
-
-	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), aws.StringValue(out.PolicyText))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECR Registry Policy (%s): setting policy: %s", d.Id(), err)
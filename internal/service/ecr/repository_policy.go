@@ -44,10 +44,7 @@ func ResourceRepositoryPolicy() *schema.Resource {
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -160,16 +157,10 @@ func resourceRepositoryPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("repository", out.RepositoryName)
 	d.Set("registry_id", out.RegistryId)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(out.PolicyText))
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), aws.StringValue(out.PolicyText))
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
-	}
-
-	policyToSet, err = structure.NormalizeJsonString(policyToSet)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policyToSet, err)
+		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", aws.StringValue(out.PolicyText), err)
 	}
 
 	d.Set("policy", policyToSet)
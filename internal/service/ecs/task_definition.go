@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -547,6 +548,8 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	d.Set("arn", taskDefinition.TaskDefinitionArn)
 	d.Set("arn_without_revision", StripRevision(aws.StringValue(taskDefinition.TaskDefinitionArn)))
 
+	diags = appendContainerDefinitionsDiffWarning(ctx, conn, diags, &taskDefinition)
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
 		err := createTags(ctx, conn, aws.StringValue(taskDefinition.TaskDefinitionArn), tags)
@@ -592,9 +595,6 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
 
-	log.Printf("[DEBUG] Received task definition %s, status:%s\n %s", aws.StringValue(out.TaskDefinition.Family),
-		aws.StringValue(out.TaskDefinition.Status), out)
-
 	taskDefinition := out.TaskDefinition
 
 	if aws.StringValue(taskDefinition.Status) == ecs.TaskDefinitionStatusInactive {
@@ -620,6 +620,9 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
+	// Environment variable values may contain secrets.
+	log.Printf("[DEBUG] Received ECS Task Definition (%s) container definitions: %s", aws.StringValue(taskDefinition.TaskDefinitionArn),
+		tfjson.MaskPaths(defs, "********", "/*/environment/*/value"))
 	err = d.Set("container_definitions", defs)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
@@ -693,6 +696,42 @@ func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// appendContainerDefinitionsDiffWarning appends a warning diagnostic describing the differences between the
+// container definitions of a new task definition revision and those of the family's previous revision.
+// container_definitions forces replacement, so the prior state is not available when the change is applied.
+func appendContainerDefinitionsDiffWarning(ctx context.Context, conn *ecs.ECS, diags diag.Diagnostics, taskDefinition *ecs.TaskDefinition) diag.Diagnostics {
+	revision := aws.Int64Value(taskDefinition.Revision)
+	if revision <= 1 {
+		return diags
+	}
+
+	previous := fmt.Sprintf("%s:%d", aws.StringValue(taskDefinition.Family), revision-1)
+	output, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(previous),
+	})
+
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read ECS Task Definition (%s) to compare container definitions: %s", previous, err)
+		return diags
+	}
+
+	var defs []string
+	for _, v := range [][]*ecs.ContainerDefinition{output.TaskDefinition.ContainerDefinitions, taskDefinition.ContainerDefinitions} {
+		containerDefinitions(v).OrderEnvironmentVariables()
+		containerDefinitions(v).OrderSecrets()
+
+		def, err := flattenContainerDefinitions(v)
+		if err != nil {
+			return diags
+		}
+
+		// Environment variable values may contain secrets.
+		defs = append(defs, tfjson.MaskPaths(def, "********", "/*/environment/*/value"))
+	}
+
+	return verify.AppendJSONDiffWarning(diags, "container_definitions", defs[0], defs[1])
+}
+
 func resourceTaskDefinitionVolumeHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
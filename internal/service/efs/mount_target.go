@@ -115,7 +115,9 @@ func resourceMountTargetCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	fsID := d.Get("file_system_id").(string)
 	mtKey := "efs-mt-" + fsID + "-" + az
-	conns.GlobalMutexKV.Lock(mtKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mtKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mtKey)
 
 	input := &efs.CreateMountTargetInput{
@@ -323,7 +325,7 @@ func waitMountTargetCreated(ctx context.Context, conn *efs.EFS, id string, timeo
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.MountTargetDescription); ok {
 		return output, err
@@ -342,7 +344,7 @@ func waitMountTargetDeleted(ctx context.Context, conn *efs.EFS, id string, timeo
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.MountTargetDescription); ok {
 		return output, err
@@ -299,7 +299,7 @@ func waitReplicationConfigurationCreated(ctx context.Context, conn *efs.EFS, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.ReplicationConfigurationDescription); ok {
 		return output, err
@@ -317,7 +317,7 @@ func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.EFS, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.ReplicationConfigurationDescription); ok {
 		return output, err
@@ -129,7 +129,9 @@ func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, m
 
 	// mutex lock for creation/deletion serialization
 	mutexKey := fmt.Sprintf("%s-fargate-profiles", clusterName)
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	// Retry for IAM eventual consistency on error:
@@ -204,7 +206,9 @@ func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, m
 
 	// mutex lock for creation/deletion serialization
 	mutexKey := fmt.Sprintf("%s-fargate-profiles", d.Get("cluster_name").(string))
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	log.Printf("[DEBUG] Deleting EKS Fargate Profile: %s", d.Id())
@@ -278,7 +282,7 @@ func waitFargateProfileCreated(ctx context.Context, conn *eks.Client, clusterNam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FargateProfile); ok {
 		return output, err
@@ -295,7 +299,7 @@ func waitFargateProfileDeleted(ctx context.Context, conn *eks.Client, clusterNam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FargateProfile); ok {
 		return output, err
@@ -290,7 +290,7 @@ func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*types.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -307,7 +307,7 @@ func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*types.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -348,7 +348,7 @@ func waitUserCreated(ctx context.Context, conn *elasticache.ElastiCache, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.User); ok {
 		return output, err
@@ -365,7 +365,7 @@ func waitUserUpdated(ctx context.Context, conn *elasticache.ElastiCache, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.User); ok {
 		return output, err
@@ -382,7 +382,7 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.ElastiCache, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.User); ok {
 		return output, err
@@ -748,7 +748,7 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.ElasticBea
 		MinTimeout:   3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
 		return output, err
@@ -768,7 +768,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.ElasticB
 		MinTimeout:   3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
 		return output, err
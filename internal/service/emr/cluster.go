@@ -1353,7 +1353,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:   10 * time.Second,
 		}
 
-		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Group (%s) modification: %s", d.Id(), instanceGroupID, err)
 		}
 	}
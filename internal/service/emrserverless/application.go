@@ -468,7 +468,7 @@ func waitApplicationCreated(ctx context.Context, conn *emrserverless.Client, id
 		Delay:      delay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
@@ -496,7 +496,7 @@ func waitApplicationTerminated(ctx context.Context, conn *emrserverless.Client,
 		Delay:      delay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
@@ -348,7 +348,7 @@ func waitEndpointCreated(ctx context.Context, conn *eventbridge.EventBridge, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
@@ -367,7 +367,7 @@ func waitEndpointUpdated(ctx context.Context, conn *eventbridge.EventBridge, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
@@ -386,7 +386,7 @@ func waitEndpointDeleted(ctx context.Context, conn *eventbridge.EventBridge, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -25,7 +26,7 @@ func waitConnectionCreated(ctx context.Context, conn *eventbridge.EventBridge, i
 		Timeout: connectionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		return v, err
@@ -42,7 +43,7 @@ func waitConnectionDeleted(ctx context.Context, conn *eventbridge.EventBridge, i
 		Timeout: connectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		return v, err
@@ -59,7 +60,7 @@ func waitConnectionUpdated(ctx context.Context, conn *eventbridge.EventBridge, i
 		Timeout: connectionUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		return v, err
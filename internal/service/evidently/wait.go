@@ -25,7 +25,7 @@ func waitFeatureCreated(ctx context.Context, conn *evidently.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
 		return output, err
@@ -42,7 +42,7 @@ func waitFeatureUpdated(ctx context.Context, conn *evidently.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
 		return output, err
@@ -59,7 +59,7 @@ func waitFeatureDeleted(ctx context.Context, conn *evidently.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
 		return output, err
@@ -76,7 +76,7 @@ func waitLaunchCreated(ctx context.Context, conn *evidently.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Launch); ok {
 		if v := aws.ToString(output.StatusReason); v != "" {
@@ -97,7 +97,7 @@ func waitLaunchUpdated(ctx context.Context, conn *evidently.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Launch); ok {
 		if v := aws.ToString(output.StatusReason); v != "" {
@@ -118,7 +118,7 @@ func waitLaunchDeleted(ctx context.Context, conn *evidently.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Launch); ok {
 		if v := aws.ToString(output.StatusReason); v != "" {
@@ -139,7 +139,7 @@ func waitProjectCreated(ctx context.Context, conn *evidently.Client, nameOrARN s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Project); ok {
 		return output, err
@@ -156,7 +156,7 @@ func waitProjectUpdated(ctx context.Context, conn *evidently.Client, nameOrARN s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Project); ok {
 		return output, err
@@ -173,7 +173,7 @@ func waitProjectDeleted(ctx context.Context, conn *evidently.Client, nameOrARN s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Project); ok {
 		return output, err
@@ -713,7 +713,7 @@ func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
 		return out, err
 	}
@@ -731,7 +731,7 @@ func waitKxClusterUpdated(ctx context.Context, conn *finspace.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
 		return out, err
 	}
@@ -747,7 +747,7 @@ func waitKxClusterDeleted(ctx context.Context, conn *finspace.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
 		return out, err
 	}
@@ -338,7 +338,7 @@ func waitKxDataviewCreated(ctx context.Context, conn *finspace.Client, id string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
 		return out, err
 	}
@@ -354,7 +354,7 @@ func waitKxDataviewUpdated(ctx context.Context, conn *finspace.Client, id string
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
 		return out, err
@@ -370,7 +370,7 @@ func waitKxDataviewDeleted(ctx context.Context, conn *finspace.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
 		return out, err
 	}
@@ -413,7 +413,7 @@ func waitKxEnvironmentCreated(ctx context.Context, conn *finspace.Client, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxEnvironmentOutput); ok {
 		return out, err
 	}
@@ -429,7 +429,7 @@ func waitTransitGatewayConfigurationUpdated(ctx context.Context, conn *finspace.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxEnvironmentOutput); ok {
 		return out, err
 	}
@@ -445,7 +445,7 @@ func waitCustomDNSConfigurationUpdated(ctx context.Context, conn *finspace.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxEnvironmentOutput); ok {
 		return out, err
 	}
@@ -461,7 +461,7 @@ func waitKxEnvironmentDeleted(ctx context.Context, conn *finspace.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxEnvironmentOutput); ok {
 		return out, err
 	}
@@ -207,7 +207,7 @@ func waitAdminAccountCreated(ctx context.Context, conn *fms.FMS, accountID strin
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fms.GetAdminAccountOutput); ok {
 		return output, err
@@ -229,7 +229,7 @@ func waitAdminAccountDeleted(ctx context.Context, conn *fms.FMS, timeout time.Du
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fms.GetAdminAccountOutput); ok {
 		return output, err
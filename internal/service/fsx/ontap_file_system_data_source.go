@@ -176,7 +176,7 @@ func dataSourceONTAPFileSystemRead(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).FSxConn(ctx)
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigForContext(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	id := d.Get("id").(string)
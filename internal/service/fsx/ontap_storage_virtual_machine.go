@@ -643,7 +643,7 @@ func waitStorageVirtualMachineCreated(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -665,7 +665,7 @@ func waitStorageVirtualMachineUpdated(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -687,7 +687,7 @@ func waitStorageVirtualMachineDeleted(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -27,7 +27,7 @@ func waitBackupAvailable(ctx context.Context, conn *fsx.FSx, id string) (*fsx.Ba
 		Timeout: backupAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -44,7 +44,7 @@ func waitBackupDeleted(ctx context.Context, conn *fsx.FSx, id string) (*fsx.Back
 		Timeout: backupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -62,7 +62,7 @@ func waitFileCacheCreated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileCache); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileCacheLifecycleFailed && details != nil {
@@ -82,7 +82,7 @@ func waitFileCacheUpdated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileCache); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileCacheLifecycleFailed && details != nil {
@@ -103,7 +103,7 @@ func waitFileCacheDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileCache); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileCacheLifecycleFailed && details != nil {
@@ -124,7 +124,7 @@ func waitSnapshotCreated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		return output, err
@@ -142,7 +142,7 @@ func waitSnapshotUpdated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   150 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		return output, err
@@ -160,7 +160,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		return output, err
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -38,7 +39,7 @@ func waitAdminAccountEnabled(ctx context.Context, conn *guardduty.GuardDuty, adm
 		Timeout: adminAccountEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*guardduty.AdminAccount); ok {
 		return output, err
@@ -56,7 +57,7 @@ func waitAdminAccountNotFound(ctx context.Context, conn *guardduty.GuardDuty, ad
 		Timeout: adminAccountNotFoundTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*guardduty.AdminAccount); ok {
 		return output, err
@@ -74,7 +75,7 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Guard
 		Timeout: publishingDestinationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*guardduty.CreatePublishingDestinationOutput); ok {
 		return v, err
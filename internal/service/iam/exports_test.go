@@ -39,4 +39,6 @@ var (
 	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
 	FindUserByName                      = findUserByName
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
+
+	NewTrimIAMRolePathFunction = newTrimIAMRolePathFunction
 )
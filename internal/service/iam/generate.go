@@ -1,7 +1,7 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
-//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice
+//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagMaxCount=50 -TagMaxKeyLength=128 -TagMaxValueLength=256 -TagAllowedChars=\p{L}\p{Z}\p{N}_.:/=+\-@ -TagKeysCaseInsensitive -TagReservedKeyPrefix=aws:
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
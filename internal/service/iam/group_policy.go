@@ -61,10 +61,7 @@ func ResourceGroupPolicy() *schema.Resource {
 				ValidateFunc:          verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := verify.LegacyPolicyNormalize(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 		},
 	}
@@ -134,7 +131,7 @@ func resourceGroupPolicyRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	policyToSet, err := verify.LegacyPolicyToSet(d.Get("policy").(string), policy)
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
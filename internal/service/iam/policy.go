@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -84,10 +85,7 @@ func resourcePolicy() *schema.Resource {
 				ValidateFunc:          verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 			"policy_id": {
 				Type:     schema.TypeString,
@@ -97,7 +95,10 @@ func resourcePolicy() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.LogJSONDiff("policy"),
+		),
 	}
 }
 
@@ -205,7 +206,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "parsing IAM Policy (%s) document: %s", d.Id(), err)
 	}
 
-	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policyDocument)
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), policyDocument)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
 	}
@@ -240,6 +241,11 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM Policy (%s): %s", d.Id(), err)
 		}
+
+		if d.HasChange("policy") {
+			o, n := d.GetChange("policy")
+			diags = verify.AppendJSONDiffWarning(diags, "policy", o.(string), n.(string))
+		}
 	}
 
 	return append(diags, resourcePolicyRead(ctx, d, meta)...)
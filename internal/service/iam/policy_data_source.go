@@ -121,6 +121,11 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "parsing IAM Policy (%s) document: %s", arn, err)
 	}
 
+	policyDocument, err = verify.CanonicalPolicyToSet(d.Get("policy").(string), policyDocument)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) document: %s", arn, err)
+	}
+
 	d.Set("policy", policyDocument)
 
 	return diags
@@ -39,6 +39,11 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"override_policy_documents": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -51,6 +56,14 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size_headroom": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"source_policy_documents": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -59,6 +72,13 @@ func DataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsJSON,
 				},
 			},
+			"split_json": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -268,7 +288,45 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	size := policySize(jsonString)
+	maxSize := policyManagedMaxSize
+	var splitJSON []string
+
+	if v, ok := d.GetOk("max_size"); ok {
+		maxSize = v.(int)
+
+		docs, err := mergedDoc.Split(maxSize)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: splitting: %s", err)
+		}
+
+		for _, doc := range docs {
+			jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+			}
+
+			splitJSON = append(splitJSON, string(jsonDoc))
+		}
+	}
+
+	warnings, err := mergedDoc.Lint()
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: linting: %s", err)
+	}
+
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Possible IAM Policy Document mistake",
+			Detail:   warning,
+		})
+	}
+
 	d.Set("json", jsonString)
+	d.Set("size", size)
+	d.Set("size_headroom", maxSize-size)
+	d.Set("split_json", splitJSON)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
@@ -524,6 +524,41 @@ data "aws_iam_policy_document" "test" {
 }
 `
 
+func TestAccIAMPolicyDocumentDataSource_maxSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_maxSize(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "size", "183"),
+					resource.TestCheckResourceAttr(dataSourceName, "size_headroom", "5961"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "0"),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_maxSize(150),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "size", "183"),
+					resource.TestCheckResourceAttr(dataSourceName, "size_headroom", "-33"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.0", `{"Version":"2012-10-17","Statement":[{"Sid":"First","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.1", `{"Version":"2012-10-17","Statement":[{"Sid":"Second","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_maxSize(50),
+				ExpectError: regexache.MustCompile(`exceeds maximum policy size \(50\)`),
+			},
+		},
+	})
+}
+
 func testAccPolicyDocumentExpectedJSON() string {
 	return fmt.Sprintf(`{
   "Version": "2012-10-17",
@@ -1614,3 +1649,28 @@ data "aws_iam_policy_document" "test" {
   override_policy_documents = ["{"]
 }
 `
+
+func testAccPolicyDocumentDataSourceConfig_maxSize(maxSize int) string {
+	var maxSizeConfig string
+	if maxSize > 0 {
+		maxSizeConfig = fmt.Sprintf("max_size = %d", maxSize)
+	}
+
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  %[1]s
+
+  statement {
+    sid       = "First"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "Second"
+    actions   = ["s3:PutObject"]
+    resources = ["*"]
+  }
+}
+`, maxSizeConfig)
+}
@@ -0,0 +1,625 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Local IAM policy evaluation.
+//
+// The evaluation logic follows the IAM policy evaluation logic for a request made within a single account:
+//   - An explicit Deny in any policy overrides any Allow
+//   - If any permissions boundary policies are specified, one of them must Allow the request
+//   - Otherwise the request is allowed if an identity-based or resource-based policy Allows the request
+//
+// Service control policies, session policies and service-specific authorization are not evaluated.
+
+const (
+	policyEvaluationDecisionAllowed      = "allowed"
+	policyEvaluationDecisionExplicitDeny = "explicitDeny"
+	policyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+const (
+	policyEvaluationSourceIdentity            = "identity"
+	policyEvaluationSourcePermissionsBoundary = "permissions-boundary"
+	policyEvaluationSourceResource            = "resource"
+)
+
+const (
+	policyEffectAllow = "Allow"
+	policyEffectDeny  = "Deny"
+)
+
+type policyEvaluationPolicy struct {
+	doc        *IAMPolicyDoc
+	id         string
+	sourceType string
+}
+
+type policyEvaluationRequest struct {
+	action    string
+	callerARN string
+	// context is keyed by lowercase context key name, as context keys are case-insensitive.
+	context  map[string][]string
+	resource string
+}
+
+type policyEvaluationMatchedStatement struct {
+	effect           string
+	sid              string
+	sourcePolicyID   string
+	sourcePolicyType string
+}
+
+type policyEvaluationResult struct {
+	decision           string
+	matchedStatements  []policyEvaluationMatchedStatement
+	missingContextKeys []string
+}
+
+// newPolicyEvaluationRequest returns a request for the specified action on the specified resource.
+// Context keys are case-insensitive.
+func newPolicyEvaluationRequest(action, resource, callerARN string, context map[string][]string) *policyEvaluationRequest {
+	request := &policyEvaluationRequest{
+		action:    action,
+		callerARN: callerARN,
+		context:   make(map[string][]string, len(context)),
+		resource:  resource,
+	}
+
+	for k, v := range context {
+		k := strings.ToLower(k)
+		request.context[k] = append(request.context[k], v...)
+	}
+
+	return request
+}
+
+// parsePolicyEvaluationDocument parses an IAM policy document.
+// Unlike the IAMPolicyDoc JSON unmarshaller, a single statement object is accepted.
+func parsePolicyEvaluationDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	if v, ok := raw["Statement"]; ok {
+		if v := bytes.TrimSpace(v); len(v) > 0 && v[0] == '{' {
+			raw["Statement"] = append(append([]byte{'['}, v...), ']')
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, err
+	}
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			return nil, fmt.Errorf("statement %d: empty", i)
+		}
+
+		if statement.Effect != policyEffectAllow && statement.Effect != policyEffectDeny {
+			return nil, fmt.Errorf("statement %d: unsupported Effect: %q", i, statement.Effect)
+		}
+	}
+
+	return doc, nil
+}
+
+// evaluatePolicies evaluates the specified policies against the request.
+func evaluatePolicies(policies []policyEvaluationPolicy, request *policyEvaluationRequest) (*policyEvaluationResult, error) {
+	var (
+		allows, boundaryAllows, denies []policyEvaluationMatchedStatement
+		principalAllows                []policyEvaluationMatchedStatement // Resource-based policy allows that name the caller, also in allows.
+		hasBoundary                    bool
+	)
+	missingContextKeys := make(map[string]struct{})
+
+	for _, policy := range policies {
+		if policy.sourceType == policyEvaluationSourcePermissionsBoundary {
+			hasBoundary = true
+		}
+
+		for i, statement := range policy.doc.Statements {
+			matched, err := policyStatementMatches(statement, policy.sourceType, request, missingContextKeys)
+
+			if err != nil {
+				return nil, fmt.Errorf("policy (%s) statement %d: %w", policy.id, i, err)
+			}
+
+			if !matched {
+				continue
+			}
+
+			matchedStatement := policyEvaluationMatchedStatement{
+				effect:           statement.Effect,
+				sid:              statement.Sid,
+				sourcePolicyID:   policy.id,
+				sourcePolicyType: policy.sourceType,
+			}
+
+			switch {
+			case statement.Effect == policyEffectDeny:
+				denies = append(denies, matchedStatement)
+			case policy.sourceType == policyEvaluationSourcePermissionsBoundary:
+				boundaryAllows = append(boundaryAllows, matchedStatement)
+			default:
+				allows = append(allows, matchedStatement)
+
+				if policy.sourceType == policyEvaluationSourceResource && policyEvaluationPrincipalNamed(statement.Principals, request.callerARN) {
+					principalAllows = append(principalAllows, matchedStatement)
+				}
+			}
+		}
+	}
+
+	result := &policyEvaluationResult{
+		decision: policyEvaluationDecisionImplicitDeny,
+	}
+
+	for k := range missingContextKeys {
+		result.missingContextKeys = append(result.missingContextKeys, k)
+	}
+	slices.Sort(result.missingContextKeys)
+
+	switch {
+	case len(denies) > 0:
+		result.decision = policyEvaluationDecisionExplicitDeny
+		result.matchedStatements = denies
+	case len(allows) > 0 && (!hasBoundary || len(boundaryAllows) > 0):
+		result.decision = policyEvaluationDecisionAllowed
+		result.matchedStatements = append(allows, boundaryAllows...)
+	case len(principalAllows) > 0:
+		// A permissions boundary doesn't limit a resource-based policy that grants access directly to the caller's ARN.
+		result.decision = policyEvaluationDecisionAllowed
+		result.matchedStatements = principalAllows
+	}
+
+	return result, nil
+}
+
+func policyStatementMatches(statement *IAMPolicyStatement, sourceType string, request *policyEvaluationRequest, missingContextKeys map[string]struct{}) (bool, error) {
+	// Action.
+	switch {
+	case statement.Actions != nil:
+		if !policyEvaluationAnyMatch(policyEvaluationStrings(statement.Actions), func(pattern string) bool {
+			return policyEvaluationWildcardMatch(pattern, request.action, true)
+		}) {
+			return false, nil
+		}
+	case statement.NotActions != nil:
+		if policyEvaluationAnyMatch(policyEvaluationStrings(statement.NotActions), func(pattern string) bool {
+			return policyEvaluationWildcardMatch(pattern, request.action, true)
+		}) {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+
+	// Resource.
+	resourceMatch := func(pattern string) bool {
+		return policyEvaluationWildcardMatch(policyEvaluationExpandVariables(pattern, request.context, missingContextKeys), request.resource, false)
+	}
+	switch {
+	case statement.Resources != nil:
+		if !policyEvaluationAnyMatch(policyEvaluationStrings(statement.Resources), resourceMatch) {
+			return false, nil
+		}
+	case statement.NotResources != nil:
+		if policyEvaluationAnyMatch(policyEvaluationStrings(statement.NotResources), resourceMatch) {
+			return false, nil
+		}
+	case sourceType != policyEvaluationSourceResource:
+		return false, nil
+	}
+
+	// Principal.
+	if sourceType == policyEvaluationSourceResource {
+		switch {
+		case len(statement.Principals) > 0:
+			if !policyEvaluationPrincipalMatches(statement.Principals, request.callerARN) {
+				return false, nil
+			}
+		case len(statement.NotPrincipals) > 0:
+			if policyEvaluationPrincipalMatches(statement.NotPrincipals, request.callerARN) {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+
+	// Condition.
+	for _, condition := range statement.Conditions {
+		matched, err := policyConditionMatches(condition, request, missingContextKeys)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func policyEvaluationPrincipalMatches(principals IAMPolicyStatementPrincipalSet, callerARN string) bool {
+	for _, principal := range principals {
+		for _, identifier := range policyEvaluationStrings(principal.Identifiers) {
+			if identifier == "*" && (principal.Type == "*" || principal.Type == "AWS") {
+				return true
+			}
+
+			if callerARN == "" {
+				continue
+			}
+
+			if identifier == callerARN {
+				return true
+			}
+
+			// An account ID or account root ARN matches any principal in the account.
+			if principal.Type == "AWS" {
+				if parts := strings.Split(callerARN, ":"); len(parts) >= 5 {
+					accountID := parts[4]
+
+					if identifier == accountID || identifier == fmt.Sprintf("arn:%s:iam::%s:root", parts[1], accountID) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// policyEvaluationPrincipalNamed returns whether the principals name the caller's ARN,
+// rather than matching it by wildcard or account.
+func policyEvaluationPrincipalNamed(principals IAMPolicyStatementPrincipalSet, callerARN string) bool {
+	if callerARN == "" {
+		return false
+	}
+
+	for _, principal := range principals {
+		if principal.Type == "AWS" && slices.Contains(policyEvaluationStrings(principal.Identifiers), callerARN) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Condition operators that negate the corresponding base operator.
+var policyConditionNegatedOperators = map[string]string{
+	"ArnNotEquals":              "ArnEquals",
+	"ArnNotLike":                "ArnLike",
+	"DateNotEquals":             "DateEquals",
+	"NotIpAddress":              "IpAddress",
+	"NumericNotEquals":          "NumericEquals",
+	"StringNotEquals":           "StringEquals",
+	"StringNotEqualsIgnoreCase": "StringEqualsIgnoreCase",
+	"StringNotLike":             "StringLike",
+}
+
+func policyConditionMatches(condition IAMPolicyStatementCondition, request *policyEvaluationRequest, missingContextKeys map[string]struct{}) (bool, error) {
+	operator := condition.Test
+	policyValues := policyEvaluationStrings(condition.Values)
+
+	var forAllValues, forAnyValue bool
+	if v, ok := strings.CutPrefix(operator, "ForAllValues:"); ok {
+		operator, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(operator, "ForAnyValue:"); ok {
+		operator, forAnyValue = v, true
+	}
+
+	requestValues, exists := request.context[strings.ToLower(condition.Variable)]
+
+	if operator == "Null" {
+		for _, v := range policyValues {
+			isNull, err := strconv.ParseBool(v)
+
+			if err != nil {
+				return false, fmt.Errorf("condition operator (%s) value (%s): %w", condition.Test, v, err)
+			}
+
+			if isNull == exists {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+
+	operator, ifExists := strings.CutSuffix(operator, "IfExists")
+	baseOperator, negated := policyConditionNegatedOperators[operator]
+	if !negated {
+		baseOperator = operator
+	}
+
+	if !exists {
+		if !ifExists {
+			missingContextKeys[condition.Variable] = struct{}{}
+		}
+
+		switch {
+		case ifExists, forAllValues:
+			return true, nil
+		case forAnyValue:
+			return false, nil
+		default:
+			return negated, nil
+		}
+	}
+
+	// matches returns whether a single request value matches any policy value using the base operator.
+	matches := func(requestValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			matched, err := policyConditionBaseOperatorMatches(baseOperator, policyEvaluationExpandVariables(policyValue, request.context, missingContextKeys), requestValue)
+
+			if err != nil {
+				return false, fmt.Errorf("condition operator (%s): %w", condition.Test, err)
+			}
+
+			if matched {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	switch {
+	case forAllValues:
+		// Every request value must satisfy the (possibly negated) operator.
+		for _, v := range requestValues {
+			matched, err := matches(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matched == negated {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case forAnyValue:
+		// At least one request value must satisfy the (possibly negated) operator.
+		for _, v := range requestValues {
+			matched, err := matches(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matched != negated {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	default:
+		// A single-valued operator on a multivalued key matches if any value matches.
+		var anyMatched bool
+		for _, v := range requestValues {
+			matched, err := matches(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matched {
+				anyMatched = true
+				break
+			}
+		}
+
+		return anyMatched != negated, nil
+	}
+}
+
+func policyConditionBaseOperatorMatches(operator, policyValue, requestValue string) (bool, error) {
+	switch operator {
+	case "StringEquals", "BinaryEquals":
+		return policyValue == requestValue, nil
+	case "StringEqualsIgnoreCase":
+		return strings.EqualFold(policyValue, requestValue), nil
+	case "StringLike":
+		return policyEvaluationWildcardMatch(policyValue, requestValue, false), nil
+	case "Bool":
+		return strings.EqualFold(policyValue, requestValue), nil
+	case "ArnEquals", "ArnLike":
+		return policyEvaluationARNMatch(policyValue, requestValue), nil
+	case "NumericEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals":
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("policy value (%s): %w", policyValue, err)
+		}
+
+		r, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		return policyConditionCompare(operator, "Numeric", r-p), nil
+	case "DateEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals":
+		p, err := policyEvaluationParseDate(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("policy value (%s): %w", policyValue, err)
+		}
+
+		r, err := policyEvaluationParseDate(requestValue)
+		if err != nil {
+			return false, nil
+		}
+
+		return policyConditionCompare(operator, "Date", float64(r.Sub(p))), nil
+	case "IpAddress":
+		_, ipNet, err := net.ParseCIDR(policyValue)
+		if err != nil {
+			if ip := net.ParseIP(policyValue); ip != nil {
+				return ip.Equal(net.ParseIP(requestValue)), nil
+			}
+
+			return false, fmt.Errorf("policy value (%s): %w", policyValue, err)
+		}
+
+		ip := net.ParseIP(requestValue)
+		if ip == nil {
+			return false, nil
+		}
+
+		return ipNet.Contains(ip), nil
+	default:
+		return false, fmt.Errorf("unsupported condition operator: %s", operator)
+	}
+}
+
+// policyConditionCompare returns whether the difference between the request and policy values satisfies the comparison operator.
+func policyConditionCompare(operator, prefix string, diff float64) bool {
+	switch strings.TrimPrefix(operator, prefix) {
+	case "Equals":
+		return diff == 0
+	case "LessThan":
+		return diff < 0
+	case "LessThanEquals":
+		return diff <= 0
+	case "GreaterThan":
+		return diff > 0
+	case "GreaterThanEquals":
+		return diff >= 0
+	default:
+		return false
+	}
+}
+
+func policyEvaluationParseDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date: %s", s)
+}
+
+// policyEvaluationExpandVariables replaces any policy variables, e.g. "${aws:username}", with the corresponding single-valued request context value.
+// Variables that cannot be resolved are left as is, which prevents any match.
+func policyEvaluationExpandVariables(s string, context map[string][]string, missingContextKeys map[string]struct{}) string {
+	if !strings.Contains(s, "${") {
+		return s
+	}
+
+	return regexache.MustCompile(`\$\{([^}]+)\}`).ReplaceAllStringFunc(s, func(v string) string {
+		key := v[2 : len(v)-1]
+
+		switch key {
+		case "*", "?", "$":
+			return key
+		}
+
+		if values := context[strings.ToLower(key)]; len(values) == 1 {
+			return values[0]
+		}
+
+		missingContextKeys[key] = struct{}{}
+
+		return v
+	})
+}
+
+// policyEvaluationWildcardMatch returns whether the value matches the pattern,
+// in which "*" matches any sequence of characters and "?" matches any single character.
+func policyEvaluationWildcardMatch(pattern, value string, caseInsensitive bool) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	expr := strings.NewReplacer(`\*`, `.*`, `\?`, `.`).Replace(regexp.QuoteMeta(pattern))
+	if caseInsensitive {
+		expr = `(?i)` + expr
+	}
+
+	return regexache.MustCompile(`^` + expr + `$`).MatchString(value)
+}
+
+// policyEvaluationARNMatch matches ARNs component by component.
+func policyEvaluationARNMatch(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	const arnComponents = 6
+	patternParts, valueParts := strings.SplitN(pattern, ":", arnComponents), strings.SplitN(value, ":", arnComponents)
+
+	if len(patternParts) != arnComponents || len(valueParts) != arnComponents {
+		return false
+	}
+
+	for i := range patternParts {
+		if !policyEvaluationWildcardMatch(patternParts[i], valueParts[i], false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func policyEvaluationAnyMatch(patterns []string, f func(string) bool) bool {
+	for _, pattern := range patterns {
+		if f(pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyEvaluationStrings returns the string values of an IAMPolicyStatement element,
+// which may be a string or a list of strings.
+func policyEvaluationStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
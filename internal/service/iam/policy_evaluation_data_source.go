@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation")
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `One or more names of actions, like "iam:CreateUser", that should be evaluated.`,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  `ARN of the principal making the evaluated requests. Used to match the Principal element of the resource policy.`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:CurrentTime".`,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values to assign to the context key.`,
+						},
+					},
+				},
+				Description: `Each block specifies one item of additional context entry to include in the evaluated requests. These are the additional properties used in the 'Condition' element of an IAM policy, and in policy variables.`,
+			},
+			"identity_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies of the principal making the evaluated requests.`,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Permissions boundary policies of the principal making the evaluated requests.`,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `ARNs of specific resources to use as the targets of the specified actions. If not specified, "*" is used.`,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `A resource policy to associate with all of the target resources.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the action whose evaluation this result is describing.`,
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The decision: "allowed", "explicitDeny", or "implicitDeny".`,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effect": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The effect of the statement: "Allow" or "Deny".`,
+									},
+									"sid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The statement identifier, if any.`,
+									},
+									"source_policy_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Identifier of one of the policies used as input to the evaluation.`,
+									},
+									"source_policy_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The type of the policy identified in source_policy_id: "identity", "resource", or "permissions-boundary".`,
+									},
+								},
+							},
+							Description: `The statements that determined the decision.`,
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `Context keys that were needed by the policies but not included in the request.`,
+						},
+						"resource_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ARN of the resource that the action was evaluated against.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policies []policyEvaluationPolicy
+
+	appendPolicy := func(policy, id, sourceType string) error {
+		doc, err := parsePolicyEvaluationDocument(policy)
+
+		if err != nil {
+			return fmt.Errorf("parsing IAM Policy (%s): %w", id, err)
+		}
+
+		policies = append(policies, policyEvaluationPolicy{
+			doc:        doc,
+			id:         id,
+			sourceType: sourceType,
+		})
+
+		return nil
+	}
+
+	for i, v := range d.Get("identity_policies_json").([]interface{}) {
+		if err := appendPolicy(v.(string), fmt.Sprintf("identity_policies_json.%d", i), policyEvaluationSourceIdentity); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	for i, v := range d.Get("permissions_boundary_policies_json").([]interface{}) {
+		if err := appendPolicy(v.(string), fmt.Sprintf("permissions_boundary_policies_json.%d", i), policyEvaluationSourcePermissionsBoundary); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		if err := appendPolicy(v.(string), "resource_policy_json", policyEvaluationSourceResource); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	requestContext := make(map[string][]string)
+	for _, v := range d.Get("context").(*schema.Set).List() {
+		tfMap := v.(map[string]interface{})
+		key := tfMap["key"].(string)
+		requestContext[key] = append(requestContext[key], flex.ExpandStringValueSet(tfMap["values"].(*schema.Set))...)
+	}
+
+	actionNames := flex.ExpandStringValueSet(d.Get("action_names").(*schema.Set))
+	slices.Sort(actionNames)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	slices.Sort(resourceARNs)
+	if len(resourceARNs) == 0 {
+		resourceARNs = []string{"*"}
+	}
+
+	callerARN := d.Get("caller_arn").(string)
+
+	// "all" are allowed only if there is at least one result and no other
+	// results were denied.
+	allAllowed := true
+	var results []interface{}
+
+	for _, actionName := range actionNames {
+		for _, resourceARN := range resourceARNs {
+			result, err := evaluatePolicies(policies, newPolicyEvaluationRequest(actionName, resourceARN, callerARN, requestContext))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies for action (%s) on resource (%s): %s", actionName, resourceARN, err)
+			}
+
+			allowed := result.decision == policyEvaluationDecisionAllowed
+			allAllowed = allAllowed && allowed
+
+			var matchedStatements []interface{}
+			for _, v := range result.matchedStatements {
+				matchedStatements = append(matchedStatements, map[string]interface{}{
+					"effect":             v.effect,
+					"sid":                v.sid,
+					"source_policy_id":   v.sourcePolicyID,
+					"source_policy_type": v.sourcePolicyType,
+				})
+			}
+
+			results = append(results, map[string]interface{}{
+				"action_name":          actionName,
+				"allowed":              allowed,
+				"decision":             result.decision,
+				"matched_statements":   matchedStatements,
+				"missing_context_keys": result.missingContextKeys,
+				"resource_arn":         resourceARN,
+			})
+		}
+	}
+
+	d.SetId("-")
+	if err := d.Set("results", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+	d.Set("all_allowed", allAllowed && len(results) > 0)
+
+	return diags
+}
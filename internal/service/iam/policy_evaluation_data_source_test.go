@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:DeleteObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.sid", "DenyDelete"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_id", "identity_policies_json.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.0.sid", "AllowRead"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.resource_arn", "arn:aws:s3:::example/key"), // lintignore:AWSAT005
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.action_name", "s3:PutObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.missing_context_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_context(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_context,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.1.source_policy_type", "permissions-boundary"),
+				),
+			},
+		},
+	})
+}
+
+// lintignore:AWSAT005
+const testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names  = ["s3:GetObject", "s3:PutObject", "s3:DeleteObject"]
+  resource_arns = ["arn:aws:s3:::example/key"]
+
+  identity_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = ["s3:Get*", "s3:DeleteObject"]
+        Resource = "arn:aws:s3:::example/*"
+      },
+      {
+        Sid      = "DenyDelete"
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+      },
+    ]
+  })]
+}
+`
+
+// lintignore:AWSAT005
+const testAccPolicyEvaluationDataSourceConfig_context = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names  = ["sqs:SendMessage"]
+  resource_arns = ["arn:aws:sqs:us-west-2:123456789012:example"]
+  caller_arn    = "arn:aws:iam::123456789012:role/example"
+
+  resource_policy_json = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = "123456789012" }
+      Action    = "sqs:SendMessage"
+      Resource  = "*"
+      Condition = {
+        StringEquals = {
+          "aws:PrincipalTag/Team" = "platform"
+        }
+      }
+    }]
+  })
+
+  permissions_boundary_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "sqs:*"
+      Resource = "*"
+    }]
+  })]
+
+  context {
+    key    = "aws:PrincipalTag/Team"
+    values = ["platform"]
+  }
+}
+`
@@ -0,0 +1,473 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		identityPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowS3Read",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": "arn:aws:s3:::example/*"
+    },
+    {
+      "Sid": "DenySecret",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "arn:aws:s3:::example/secret/*"
+    },
+    {
+      "Sid": "AllowHome",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/home/${aws:username}/*"
+    },
+    {
+      "Sid": "AllowTaggedInstances",
+      "Effect": "Allow",
+      "Action": "ec2:StopInstances",
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {"aws:ResourceTag/Team": "platform"},
+        "Bool": {"aws:MultiFactorAuthPresent": "true"}
+      }
+    },
+    {
+      "Sid": "AllowFromNetwork",
+      "Effect": "Allow",
+      "Action": "ec2:StartInstances",
+      "Resource": "*",
+      "Condition": {
+        "IpAddress": {"aws:SourceIp": "10.0.0.0/8"},
+        "NumericLessThanEquals": {"aws:MultiFactorAuthAge": 3600}
+      }
+    },
+    {
+      "Sid": "AllowRequestTags",
+      "Effect": "Allow",
+      "Action": "ec2:CreateTags",
+      "Resource": "*",
+      "Condition": {
+        "ForAllValues:StringEquals": {"aws:TagKeys": ["Name", "Team"]}
+      }
+    },
+    {
+      "Sid": "AllowNotIAM",
+      "Effect": "Allow",
+      "NotAction": "iam:*",
+      "Resource": "arn:aws:sqs:*:123456789012:*"
+    }
+  ]
+}` // lintignore:AWSAT003,AWSAT005
+		resourcePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "AllowCrossAccount",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::210987654321:root"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/shared/*"
+  }
+}` // lintignore:AWSAT005
+		callerResourcePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowCaller",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:user/alice"},
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:aws:sqs:us-west-2:123456789012:queue"
+    },
+    {
+      "Sid": "AllowAccount",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": "sqs:ReceiveMessage",
+      "Resource": "arn:aws:sqs:us-west-2:123456789012:queue"
+    }
+  ]
+}` // lintignore:AWSAT003,AWSAT005
+		boundaryPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:*", "ec2:*"],
+      "Resource": "*"
+    }
+  ]
+}`
+	)
+
+	parse := func(t *testing.T, policy string) *IAMPolicyDoc {
+		t.Helper()
+
+		doc, err := parsePolicyEvaluationDocument(policy)
+		if err != nil {
+			t.Fatalf("parsing policy: %s", err)
+		}
+
+		return doc
+	}
+
+	identity := policyEvaluationPolicy{doc: parse(t, identityPolicy), id: "identity", sourceType: policyEvaluationSourceIdentity}
+	resource := policyEvaluationPolicy{doc: parse(t, resourcePolicy), id: "resource", sourceType: policyEvaluationSourceResource}
+	callerResource := policyEvaluationPolicy{doc: parse(t, callerResourcePolicy), id: "caller-resource", sourceType: policyEvaluationSourceResource}
+	boundary := policyEvaluationPolicy{doc: parse(t, boundaryPolicy), id: "boundary", sourceType: policyEvaluationSourcePermissionsBoundary}
+
+	testCases := []struct {
+		name     string
+		policies []policyEvaluationPolicy
+		request  *policyEvaluationRequest
+		want     *policyEvaluationResult
+	}{
+		{
+			name:    "no policies",
+			request: newPolicyEvaluationRequest("s3:GetObject", "arn:aws:s3:::example/key", "", nil), // lintignore:AWSAT005
+			want:    &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "allowed wildcard action",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("S3:GetObjectTagging", "arn:aws:s3:::example/key", "", nil), // lintignore:AWSAT005
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowS3Read", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "explicit deny",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("s3:GetObject", "arn:aws:s3:::example/secret/key", "", nil), // lintignore:AWSAT005
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionExplicitDeny,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectDeny, sid: "DenySecret", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "implicit deny resource",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("s3:GetObject", "arn:aws:s3:::other/key", "", nil), // lintignore:AWSAT005
+			want:     &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "policy variable",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("s3:PutObject", "arn:aws:s3:::example/home/alice/key", "", map[string][]string{ // lintignore:AWSAT005
+				"aws:username": {"alice"},
+			}),
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowHome", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "policy variable missing",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("s3:PutObject", "arn:aws:s3:::example/home/alice/key", "", nil), // lintignore:AWSAT005
+			want: &policyEvaluationResult{
+				decision:           policyEvaluationDecisionImplicitDeny,
+				missingContextKeys: []string{"aws:username"},
+			},
+		},
+		{
+			name:     "conditions satisfied",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("ec2:StopInstances", "*", "", map[string][]string{
+				"aws:ResourceTag/Team":       {"platform"},
+				"aws:multifactorauthpresent": {"true"},
+			}),
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowTaggedInstances", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "condition not satisfied",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("ec2:StopInstances", "*", "", map[string][]string{
+				"aws:ResourceTag/Team":       {"data"},
+				"aws:MultiFactorAuthPresent": {"true"},
+			}),
+			want: &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "condition key missing",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("ec2:StopInstances", "*", "", nil),
+			want: &policyEvaluationResult{
+				decision:           policyEvaluationDecisionImplicitDeny,
+				missingContextKeys: []string{"aws:ResourceTag/Team"},
+			},
+		},
+		{
+			name:     "ip address and numeric conditions",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("ec2:StartInstances", "*", "", map[string][]string{
+				"aws:SourceIp":           {"10.1.2.3"},
+				"aws:MultiFactorAuthAge": {"60"},
+			}),
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowFromNetwork", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "ip address condition not satisfied",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("ec2:StartInstances", "*", "", map[string][]string{
+				"aws:SourceIp":           {"192.168.0.1"},
+				"aws:MultiFactorAuthAge": {"60"},
+			}),
+			want: &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "for all values satisfied",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("ec2:CreateTags", "*", "", map[string][]string{
+				"aws:TagKeys": {"Name"},
+			}),
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowRequestTags", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "for all values not satisfied",
+			policies: []policyEvaluationPolicy{identity},
+			request: newPolicyEvaluationRequest("ec2:CreateTags", "*", "", map[string][]string{
+				"aws:TagKeys": {"Name", "Owner"},
+			}),
+			want: &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "not action",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("sqs:SendMessage", "arn:aws:sqs:us-west-2:123456789012:queue", "", nil), // lintignore:AWSAT003,AWSAT005
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowNotIAM", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+				},
+			},
+		},
+		{
+			name:     "not action excluded",
+			policies: []policyEvaluationPolicy{identity},
+			request:  newPolicyEvaluationRequest("iam:CreateUser", "arn:aws:sqs:us-west-2:123456789012:queue", "", nil), // lintignore:AWSAT003,AWSAT005
+			want:     &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "resource policy principal",
+			policies: []policyEvaluationPolicy{identity, resource},
+			request:  newPolicyEvaluationRequest("s3:PutObject", "arn:aws:s3:::example/shared/key", "arn:aws:iam::210987654321:role/writer", nil), // lintignore:AWSAT005
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowCrossAccount", sourcePolicyID: "resource", sourcePolicyType: policyEvaluationSourceResource},
+				},
+				missingContextKeys: []string{"aws:username"},
+			},
+		},
+		{
+			name:     "resource policy other principal",
+			policies: []policyEvaluationPolicy{identity, resource},
+			request:  newPolicyEvaluationRequest("s3:PutObject", "arn:aws:s3:::example/shared/key", "arn:aws:iam::123456789012:role/writer", nil), // lintignore:AWSAT005
+			want: &policyEvaluationResult{
+				decision:           policyEvaluationDecisionImplicitDeny,
+				missingContextKeys: []string{"aws:username"},
+			},
+		},
+		{
+			name:     "permissions boundary allows",
+			policies: []policyEvaluationPolicy{identity, boundary},
+			request:  newPolicyEvaluationRequest("s3:GetObject", "arn:aws:s3:::example/key", "", nil), // lintignore:AWSAT005
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowS3Read", sourcePolicyID: "identity", sourcePolicyType: policyEvaluationSourceIdentity},
+					{effect: policyEffectAllow, sourcePolicyID: "boundary", sourcePolicyType: policyEvaluationSourcePermissionsBoundary},
+				},
+			},
+		},
+		{
+			name:     "permissions boundary does not allow",
+			policies: []policyEvaluationPolicy{identity, boundary},
+			request:  newPolicyEvaluationRequest("sqs:SendMessage", "arn:aws:sqs:us-west-2:123456789012:queue", "", nil), // lintignore:AWSAT003,AWSAT005
+			want:     &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+		{
+			name:     "permissions boundary resource policy names caller",
+			policies: []policyEvaluationPolicy{callerResource, boundary},
+			request:  newPolicyEvaluationRequest("sqs:SendMessage", "arn:aws:sqs:us-west-2:123456789012:queue", "arn:aws:iam::123456789012:user/alice", nil), // lintignore:AWSAT003,AWSAT005
+			want: &policyEvaluationResult{
+				decision: policyEvaluationDecisionAllowed,
+				matchedStatements: []policyEvaluationMatchedStatement{
+					{effect: policyEffectAllow, sid: "AllowCaller", sourcePolicyID: "caller-resource", sourcePolicyType: policyEvaluationSourceResource},
+				},
+			},
+		},
+		{
+			name:     "permissions boundary resource policy account principal",
+			policies: []policyEvaluationPolicy{callerResource, boundary},
+			request:  newPolicyEvaluationRequest("sqs:ReceiveMessage", "arn:aws:sqs:us-west-2:123456789012:queue", "arn:aws:iam::123456789012:user/alice", nil), // lintignore:AWSAT003,AWSAT005
+			want:     &policyEvaluationResult{decision: policyEvaluationDecisionImplicitDeny},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := evaluatePolicies(testCase.policies, testCase.request)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(policyEvaluationResult{}, policyEvaluationMatchedStatement{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConditionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		condition IAMPolicyStatementCondition
+		context   map[string][]string
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "StringLike",
+			condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/*"}},
+			context:   map[string][]string{"s3:prefix": {"home/alice"}},
+			want:      true,
+		},
+		{
+			name:      "StringNotEquals missing key",
+			condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:PrincipalTag/Team", Values: []string{"data"}},
+			want:      true,
+		},
+		{
+			name:      "StringNotEquals multivalued",
+			condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:TagKeys", Values: []string{"Owner"}},
+			context:   map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			want:      false,
+		},
+		{
+			name:      "StringEqualsIgnoreCase",
+			condition: IAMPolicyStatementCondition{Test: "StringEqualsIgnoreCase", Variable: "aws:PrincipalTag/Team", Values: "DATA"},
+			context:   map[string][]string{"aws:PrincipalTag/Team": {"data"}},
+			want:      true,
+		},
+		{
+			name:      "IfExists missing key",
+			condition: IAMPolicyStatementCondition{Test: "StringEqualsIfExists", Variable: "ec2:InstanceType", Values: []string{"t3.micro"}},
+			want:      true,
+		},
+		{
+			name:      "ForAnyValue",
+			condition: IAMPolicyStatementCondition{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"Owner"}},
+			context:   map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			want:      true,
+		},
+		{
+			name:      "ForAnyValue missing key",
+			condition: IAMPolicyStatementCondition{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"Owner"}},
+			want:      false,
+		},
+		{
+			name:      "Null true",
+			condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: "true"},
+			want:      true,
+		},
+		{
+			name:      "Null false",
+			condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: "false"},
+			want:      false,
+		},
+		{
+			name:      "DateLessThan",
+			condition: IAMPolicyStatementCondition{Test: "DateLessThan", Variable: "aws:CurrentTime", Values: []string{"2030-01-01T00:00:00Z"}},
+			context:   map[string][]string{"aws:CurrentTime": {"2024-06-30T12:00:00Z"}},
+			want:      true,
+		},
+		{
+			name:      "DateGreaterThan epoch",
+			condition: IAMPolicyStatementCondition{Test: "DateGreaterThan", Variable: "aws:EpochTime", Values: []string{"1700000000"}},
+			context:   map[string][]string{"aws:EpochTime": {"1600000000"}},
+			want:      false,
+		},
+		{
+			name:      "ArnLike",
+			condition: IAMPolicyStatementCondition{Test: "ArnLike", Variable: "aws:SourceArn", Values: []string{"arn:aws:sns:*:123456789012:*"}}, // lintignore:AWSAT005
+			context:   map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}},                                        // lintignore:AWSAT003,AWSAT005
+			want:      true,
+		},
+		{
+			name:      "ArnNotLike",
+			condition: IAMPolicyStatementCondition{Test: "ArnNotLike", Variable: "aws:SourceArn", Values: []string{"arn:aws:sns:*:123456789012:*"}}, // lintignore:AWSAT005
+			context:   map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:210987654321:topic"}},                                           // lintignore:AWSAT003,AWSAT005
+			want:      true,
+		},
+		{
+			name:      "NotIpAddress",
+			condition: IAMPolicyStatementCondition{Test: "NotIpAddress", Variable: "aws:SourceIp", Values: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+			context:   map[string][]string{"aws:SourceIp": {"192.168.1.1"}},
+			want:      false,
+		},
+		{
+			name:      "unsupported operator",
+			condition: IAMPolicyStatementCondition{Test: "StringMatches", Variable: "aws:SourceIp", Values: []string{"x"}},
+			context:   map[string][]string{"aws:SourceIp": {"x"}},
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := policyConditionMatches(testCase.condition, newPolicyEvaluationRequest("", "", "", testCase.context), make(map[string]struct{}))
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("policyConditionMatches() err = %v, wantErr %t", err, want)
+			}
+
+			if got != testCase.want {
+				t.Errorf("policyConditionMatches() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"unicode"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/jmespath/go-jmespath"
 )
 
@@ -18,6 +20,12 @@ const (
 	policyModelMarshallJSONStartSliceSize = 2
 )
 
+const (
+	// Maximum size of a managed policy document, not counting whitespace.
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+	policyManagedMaxSize = 6144
+)
+
 type IAMPolicyDoc struct {
 	Version    string                `json:",omitempty"`
 	Id         string                `json:",omitempty"`
@@ -82,6 +90,61 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// Split splits the policy document into one or more documents, each of which is no larger than maxSize
+// as counted by policySize. Statements are kept intact and in order.
+// An error is returned if any single statement does not fit in a document of maxSize.
+func (s *IAMPolicyDoc) Split(maxSize int) ([]*IAMPolicyDoc, error) {
+	newDoc := func() *IAMPolicyDoc {
+		return &IAMPolicyDoc{
+			Version: s.Version,
+			Id:      s.Id,
+		}
+	}
+
+	docSize := func(doc *IAMPolicyDoc) (int, error) {
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return 0, err
+		}
+
+		return policySize(string(b)), nil
+	}
+
+	doc := newDoc()
+	docs := []*IAMPolicyDoc{doc}
+
+	for i, statement := range s.Statements {
+		doc.Statements = append(doc.Statements, statement)
+
+		size, err := docSize(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		if size <= maxSize {
+			continue
+		}
+
+		// Move the statement to a new document, unless it is the only statement in this one.
+		if len(doc.Statements) > 1 {
+			doc.Statements = doc.Statements[:len(doc.Statements)-1]
+			doc = newDoc()
+			doc.Statements = append(doc.Statements, statement)
+			docs = append(docs, doc)
+
+			if size, err = docSize(doc); err != nil {
+				return nil, err
+			}
+		}
+
+		if size > maxSize {
+			return nil, fmt.Errorf("statement %d (%s): size (%d) exceeds maximum policy size (%d)", i, statement.Sid, size, maxSize)
+		}
+	}
+
+	return docs, nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -219,10 +282,19 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case bool:
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case float64:
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatFloat(var_values, 'f', -1, 64)})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					switch v := v.(type) {
+					case bool:
+						values = append(values, strconv.FormatBool(v))
+					case float64:
+						values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+					default:
+						values = append(values, v.(string))
+					}
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
@@ -245,6 +317,31 @@ func policyDecodeConfigStringList(lI []interface{}) interface{} {
 	return ret
 }
 
+// Lint returns warnings about likely mistakes in the policy document.
+// See iampolicy.Lint.
+func (s *IAMPolicyDoc) Lint() ([]string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return iampolicy.Lint(string(b))
+}
+
+// policySize returns the size of an IAM policy document as counted against IAM's quotas,
+// i.e. the number of characters excluding any whitespace.
+func policySize(policy string) int {
+	var n int
+
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+
+	return n
+}
+
 // PolicyHasValidAWSPrincipals validates that the Principals in an IAM Policy are valid
 // Assumes that non-"AWS" Principals are valid
 // The value can be a single string or a slice of strings
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -390,3 +391,171 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 		})
 	}
 }
+
+func TestPolicySize(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy string
+		want   int
+	}{
+		"empty": {
+			policy: ``,
+			want:   0,
+		},
+		"compact": {
+			policy: `{"Version":"2012-10-17"}`,
+			want:   24,
+		},
+		"indented": {
+			policy: "{\n  \"Version\": \"2012-10-17\"\n}",
+			want:   24,
+		},
+		"multibyte": {
+			policy: `{"Sid":"é"}`,
+			want:   11,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := policySize(testcase.policy), testcase.want; got != want {
+				t.Errorf("policySize() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDoc_Split(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	statement := func(sid string) *IAMPolicyStatement {
+		return &IAMPolicyStatement{
+			Sid:       sid,
+			Effect:    "Allow",
+			Actions:   "s3:GetObject",
+			Resources: "*",
+		}
+	}
+	doc := &IAMPolicyDoc{
+		Version:    "2012-10-17",
+		Statements: []*IAMPolicyStatement{statement("One"), statement("Two"), statement("Three")},
+	}
+	// Size of the largest single statement document, {"Version":"2012-10-17","Statement":[{"Sid":"Three",...}]}.
+	singleStatementSize := 110
+
+	testcases := map[string]struct {
+		maxSize  int
+		wantSids [][]string
+		wantErr  bool
+	}{
+		"fits": {
+			maxSize:  policyManagedMaxSize,
+			wantSids: [][]string{{"One", "Two", "Three"}},
+		},
+		"two per document": {
+			maxSize:  2 * singleStatementSize,
+			wantSids: [][]string{{"One", "Two"}, {"Three"}},
+		},
+		"one per document": {
+			maxSize:  singleStatementSize,
+			wantSids: [][]string{{"One"}, {"Two"}, {"Three"}},
+		},
+		"statement too large": {
+			maxSize: 50,
+			wantErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			docs, err := doc.Split(testcase.maxSize)
+
+			if got, want := err != nil, testcase.wantErr; got != want {
+				t.Fatalf("Split() err = %v, wantErr %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			var gotSids [][]string
+			for _, doc := range docs {
+				if got, want := doc.Version, "2012-10-17"; got != want {
+					t.Errorf("Split() Version = %s, want %s", got, want)
+				}
+
+				b, err := json.Marshal(doc)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got := policySize(string(b)); got > testcase.maxSize {
+					t.Errorf("Split() document size = %d, want <= %d", got, testcase.maxSize)
+				}
+
+				var sids []string
+				for _, statement := range doc.Statements {
+					sids = append(sids, statement.Sid)
+				}
+				gotSids = append(gotSids, sids)
+			}
+
+			if !reflect.DeepEqual(gotSids, testcase.wantSids) {
+				t.Errorf("Split() Sids = %v, want %v", gotSids, testcase.wantSids)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDoc_Lint(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Sid:       "Clean",
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObject", "s3:PutObject"},
+				Resources: "arn:aws:s3:::bucket/*",
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "aws:username", Values: "bob"},
+				},
+			},
+			{
+				Sid:    "Mistakes",
+				Effect: "Allow",
+				NotPrincipals: IAMPolicyStatementPrincipalSet{
+					{Type: "AWS", Identifiers: "arn:aws:iam::123456789012:root"},
+				},
+				Actions:   "s3:GetObject",
+				Resources: "*",
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEqual", Variable: "aws:username", Values: "bob"},
+				},
+			},
+		},
+	}
+
+	got, err := doc.Lint()
+
+	if err != nil {
+		t.Fatalf("Lint() err = %s", err)
+	}
+
+	want := []string{
+		"statement 1 (Mistakes): NotPrincipal with Effect Allow grants access to all principals except those listed, including anonymous users",
+		"statement 1 (Mistakes): unknown condition operator (StringEqual)",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -63,10 +63,7 @@ func resourceRole() *schema.Resource {
 				ValidateFunc:          verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 			"create_date": {
 				Type:     schema.TypeString,
@@ -297,7 +294,7 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	policyToSet, err := verify.PolicyToSet(d.Get("assume_role_policy").(string), assumeRolePolicy)
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("assume_role_policy").(string), assumeRolePolicy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
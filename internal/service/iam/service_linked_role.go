@@ -258,7 +258,7 @@ func waitServiceLinkedRoleDeleted(ctx context.Context, conn *iam.IAM, id string)
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iam.GetServiceLinkedRoleDeletionStatusOutput); ok {
 		if reason := output.Reason; reason != nil {
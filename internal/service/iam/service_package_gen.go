@@ -19,6 +19,15 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (p *servicePackage) FrameworkFunctions(ctx context.Context) []*types.ServicePackageFrameworkFunction {
+	return []*types.ServicePackageFrameworkFunction{
+		{
+			Factory: newTrimIAMRolePathFunction,
+			Name:    "Trim IAM Role Path",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{}
 }
@@ -57,6 +66,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePolicyDocument,
 			TypeName: "aws_iam_policy_document",
 		},
+		{
+			Factory:  DataSourcePolicyEvaluation,
+			TypeName: "aws_iam_policy_evaluation",
+		},
 		{
 			Factory:  DataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
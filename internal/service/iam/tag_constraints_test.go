@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagConstraintsValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	constraints := (&servicePackage{}).TagConstraints()
+
+	testCases := map[string]struct {
+		tags    map[string]string
+		wantErr bool
+	}{
+		"normal": {
+			tags: map[string]string{
+				"Name":        "foo",
+				"Environment": "test value",
+				"cost-center": "team@example.com:1/2=3+4",
+			},
+		},
+		"disallowed character": {
+			tags: map[string]string{
+				"Name": "foo*",
+			},
+			wantErr: true,
+		},
+		"reserved prefix": {
+			tags: map[string]string{
+				"aws:Name": "foo",
+			},
+			wantErr: true,
+		},
+		"keys differ only in case": {
+			tags: map[string]string{
+				"Name": "foo",
+				"name": "bar",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := constraints.Validate(tftags.New(ctx, testCase.tags))
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Validate() err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
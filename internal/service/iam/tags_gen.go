@@ -57,3 +57,15 @@ func setTagsOut(ctx context.Context, tags []*iam.Tag) {
 		inContext.TagsOut = option.Some(KeyValueTags(ctx, tags))
 	}
 }
+
+// TagConstraints returns the iam service's constraints on the tags of a single resource.
+func (p *servicePackage) TagConstraints() *tftags.Constraints {
+	return &tftags.Constraints{
+		MaxCount:            50,
+		MaxKeyLength:        128,
+		MaxValueLength:      256,
+		AllowedCharacters:   tftags.AllowedCharactersDefault,
+		KeysCaseInsensitive: true,
+		ReservedKeyPrefix:   "aws:",
+	}
+}
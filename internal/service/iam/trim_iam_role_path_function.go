@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var _ function.Function = trimIAMRolePathFunction{}
+
+// @Function(name="Trim IAM Role Path")
+func newTrimIAMRolePathFunction() function.Function {
+	return &trimIAMRolePathFunction{}
+}
+
+type trimIAMRolePathFunction struct{}
+
+func (f trimIAMRolePathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_iam_role_path"
+}
+
+func (f trimIAMRolePathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "trim_iam_role_path Function",
+		MarkdownDescription: "Trims the path prefix from an IAM role Amazon Resource Name (ARN). This function can be used when services require role ARNs to be passed without a path.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:       "arn",
+				CustomType: fwtypes.ARNType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f trimIAMRolePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var data fwtypes.ARN
+
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// fwtypes.ARNType maps values which cannot be parsed as an ARN to Unknown.
+	if data.IsUnknown() {
+		resp.Diagnostics.AddError("Invalid ARN", "The value provided cannot be parsed as an ARN.")
+		return
+	}
+
+	result, err := trimRolePath(data.ValueARN())
+	if err != nil {
+		resp.Diagnostics.AddError("Trimming IAM role path", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, result)...)
+}
+
+// trimRolePath removes all path prefixes from the resource section of a role ARN.
+func trimRolePath(v arn.ARN) (string, error) {
+	parts := strings.Split(v.Resource, "/")
+	if v.Service != "iam" || len(parts) < 2 || parts[0] != "role" {
+		return "", fmt.Errorf("%q is not an IAM role ARN", v.String())
+	}
+
+	v.Resource = fmt.Sprintf("%s/%s", parts[0], parts[len(parts)-1])
+
+	return v.String(), nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestTrimIAMRolePathFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arg           attr.Value
+		expected      attr.Value
+		expectedError bool
+	}{
+		"no path": {
+			arg:      fwtypes.ARNValue("arn:aws:iam::444455556666:role/example"),  // lintignore:AWSAT005
+			expected: types.StringValue("arn:aws:iam::444455556666:role/example"), // lintignore:AWSAT005
+		},
+		"single path element": {
+			arg:      fwtypes.ARNValue("arn:aws:iam::444455556666:role/path/example"), // lintignore:AWSAT005
+			expected: types.StringValue("arn:aws:iam::444455556666:role/example"),     // lintignore:AWSAT005
+		},
+		"multiple path elements": {
+			arg:      fwtypes.ARNValue("arn:aws:iam::444455556666:role/with/multiple/paths/example"), // lintignore:AWSAT005
+			expected: types.StringValue("arn:aws:iam::444455556666:role/example"),                    // lintignore:AWSAT005
+		},
+		"not a role": {
+			arg:           fwtypes.ARNValue("arn:aws:iam::444455556666:user/path/example"), // lintignore:AWSAT005
+			expectedError: true,
+		},
+		"invalid ARN": {
+			arg:           fwtypes.ARNUnknown(),
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{testCase.arg}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			tfiam.NewTrimIAMRolePathFunction().Run(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectedError; got != want {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if testCase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(response.Result.Value(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -126,7 +127,9 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return diags
 	}
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
+	if err := conns.GlobalMutexKV.LockContext(ctx, orgConfigMutex); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
 
 	log.Printf("[DEBUG] Updating Inspector2 Organization Configuration (%s): %#v", d.Id(), in)
@@ -147,7 +150,9 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 
 	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
+	if err := conns.GlobalMutexKV.LockContext(ctx, orgConfigMutex); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
 
 	in := &inspector2.UpdateOrganizationConfigurationInput{
@@ -211,7 +216,7 @@ func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.
 		MinTimeout:                time.Second * 5,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -301,7 +301,7 @@ func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -320,7 +320,7 @@ func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -339,7 +339,7 @@ func waitTopicRuleDestinationDisabled(ctx context.Context, conn *iot.IoT, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -358,7 +358,7 @@ func waitTopicRuleDestinationEnabled(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -837,7 +837,7 @@ func waitDataSourceCreated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
 		if output.Status == types.DataSourceStatusFailed {
@@ -859,7 +859,7 @@ func waitDataSourceUpdated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
 		if output.Status == types.DataSourceStatusFailed {
@@ -879,7 +879,7 @@ func waitDataSourceDeleted(ctx context.Context, conn *kendra.Client, id, indexId
 		Refresh: statusDataSource(ctx, conn, id, indexId),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwplanmodifiers "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -48,6 +49,9 @@ func (r *resourcePolicyResource) Schema(ctx context.Context, request resource.Sc
 			"policy": schema.StringAttribute{
 				CustomType: fwtypes.IAMPolicyType,
 				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					fwplanmodifiers.JSONDiffWarning(),
+				},
 			},
 			"resource_arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
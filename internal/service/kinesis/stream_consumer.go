@@ -182,7 +182,7 @@ func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConsumerDescription); ok {
 		return output, err
@@ -202,7 +202,7 @@ func waitStreamConsumerDeleted(ctx context.Context, conn *kinesis.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConsumerDescription); ok {
 		return output, err
@@ -245,7 +245,7 @@ func resourceReplicaExternalKeyRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("key_state", key.metadata.KeyState)
 	d.Set("key_usage", key.metadata.KeyUsage)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), key.policy)
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), key.policy)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", key.policy, err)
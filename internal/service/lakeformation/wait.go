@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/lakeformation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +30,7 @@ func waitPermissionsReady(ctx context.Context, conn *lakeformation.LakeFormation
 		Timeout: permissionsReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*lakeformation.PrincipalResourcePermissions); ok {
 		return output, err
@@ -1193,7 +1193,7 @@ func waitEventSourceMappingCreate(ctx context.Context, conn *lambda.Lambda, id s
 		Timeout: eventSourceMappingCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
@@ -1212,7 +1212,7 @@ func waitEventSourceMappingDelete(ctx context.Context, conn *lambda.Lambda, id s
 		Timeout: eventSourceMappingDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
@@ -1231,7 +1231,7 @@ func waitEventSourceMappingUpdate(ctx context.Context, conn *lambda.Lambda, id s
 		Timeout: eventSourceMappingUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
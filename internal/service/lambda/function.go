@@ -489,7 +489,9 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 	if v, ok := d.GetOk("filename"); ok {
 		// Grab an exclusive lock so that we're only reading one function into memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364.
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		zipFile, err := readFileContents(v.(string))
@@ -948,7 +950,9 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(mutexKey)
+			if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 			defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 			zipFile, err := readFileContents(v.(string))
@@ -1173,7 +1177,7 @@ func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string,
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FunctionConfiguration); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.StateReasonCode), aws.ToString(output.StateReason)))
@@ -1193,7 +1197,7 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FunctionConfiguration); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastUpdateStatusReasonCode), aws.ToString(output.LastUpdateStatusReason)))
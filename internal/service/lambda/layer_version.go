@@ -159,7 +159,9 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 
 	var layerContent *lambda.LayerVersionContentInput
 	if hasFilename {
-		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexLayerKey); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 		file, err := readFileContents(filename.(string))
 		if err != nil {
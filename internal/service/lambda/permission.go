@@ -121,7 +121,9 @@ func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, functionName); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(functionName)
 
 	input := &lambda.AddPermissionInput{
@@ -250,7 +252,9 @@ func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, functionName); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(functionName)
 
 	input := &lambda.RemovePermissionInput{
@@ -126,3 +126,13 @@ func updateTags(ctx context.Context, conn *lambda.Client, identifier string, old
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
 	return updateTags(ctx, meta.(*conns.AWSClient).LambdaClient(ctx), identifier, oldTags, newTags)
 }
+
+// TagConstraints returns the lambda service's constraints on the tags of a single resource.
+func (p *servicePackage) TagConstraints() *tftags.Constraints {
+	return &tftags.Constraints{
+		MaxCount:          50,
+		MaxKeyLength:      128,
+		MaxValueLength:    256,
+		ReservedKeyPrefix: "aws:",
+	}
+}
@@ -31,7 +31,7 @@ func waitBotVersionCreated(ctx context.Context, conn *lexmodelbuildingservice.Le
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if output, ok := outputRaw.(*lexmodelbuildingservice.GetBotOutput); ok {
 		if status := aws.StringValue(output.Status); status == lexmodelbuildingservice.StatusFailed {
@@ -56,7 +56,7 @@ func waitBotDeleted(ctx context.Context, conn *lexmodelbuildingservice.LexModelB
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if output, ok := outputRaw.(*lexmodelbuildingservice.GetBotOutput); ok {
 		if status := aws.StringValue(output.Status); status == lexmodelbuildingservice.StatusFailed {
@@ -76,7 +76,7 @@ func waitBotAliasDeleted(ctx context.Context, conn *lexmodelbuildingservice.LexM
 		Refresh: statusBotAlias(ctx, conn, botAliasName, botName),
 		Timeout: botAliasDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetBotAliasOutput); ok {
 		return v, err
@@ -92,7 +92,7 @@ func waitIntentDeleted(ctx context.Context, conn *lexmodelbuildingservice.LexMod
 		Refresh: statusIntent(ctx, conn, intentId),
 		Timeout: intentDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetIntentVersionsOutput); ok {
 		return v, err
@@ -108,7 +108,7 @@ func waitSlotTypeDeleted(ctx context.Context, conn *lexmodelbuildingservice.LexM
 		Refresh: statusSlotType(ctx, conn, name, SlotTypeVersionLatest),
 		Timeout: slotTypeDeleteTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetSlotTypeOutput); ok {
 		return v, err
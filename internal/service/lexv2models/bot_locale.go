@@ -379,7 +379,7 @@ func waitBotLocaleCreated(ctx context.Context, conn *lexmodelsv2.Client, id stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
 		return out, err
 	}
@@ -397,7 +397,7 @@ func waitBotLocaleUpdated(ctx context.Context, conn *lexmodelsv2.Client, id stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
 		return out, err
 	}
@@ -413,7 +413,7 @@ func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
 		return out, err
 	}
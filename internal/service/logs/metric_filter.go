@@ -125,7 +125,9 @@ func resourceMetricFilterPut(ctx context.Context, d *schema.ResourceData, meta i
 	// clashes, so use a mutex here (and on deletion) to serialise actions on
 	// log groups.
 	mutexKey := fmt.Sprintf(`log-group-%s`, logGroupName)
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	_, err := conn.PutMetricFilter(ctx, input)
@@ -177,7 +179,9 @@ func resourceMetricFilterDelete(ctx context.Context, d *schema.ResourceData, met
 	// clashes, so use a mutex here (and on creation) to serialise actions on
 	// log groups.
 	mutexKey := fmt.Sprintf(`log-group-%s`, d.Get(`log_group_name`))
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	log.Printf("[INFO] Deleting CloudWatch Logs Metric Filter: %s", d.Id())
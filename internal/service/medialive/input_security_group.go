@@ -197,7 +197,7 @@ func waitInputSecurityGroupCreated(ctx context.Context, conn *medialive.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
@@ -215,7 +215,7 @@ func waitInputSecurityGroupUpdated(ctx context.Context, conn *medialive.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
@@ -231,7 +231,7 @@ func waitInputSecurityGroupDeleted(ctx context.Context, conn *medialive.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
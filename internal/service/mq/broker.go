@@ -690,7 +690,7 @@ func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
 		return output, err
@@ -711,7 +711,7 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
 		return output, err
@@ -727,7 +727,7 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
 		return output, err
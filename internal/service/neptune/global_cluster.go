@@ -400,7 +400,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *neptune.Neptune, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
 		return output, err
@@ -418,7 +418,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Neptune, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
 		return output, err
@@ -436,7 +436,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Neptune, id str
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
 		return output, err
@@ -417,7 +417,7 @@ func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
 		return output, err
@@ -434,7 +434,7 @@ func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
 		return output, err
@@ -453,7 +453,7 @@ func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh:    statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
 		return output, err
@@ -600,7 +600,7 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Netw
 		Refresh: statusCoreNetworkPolicyState(ctx, conn, coreNetworkId, policyVersionId),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
 		return output, err
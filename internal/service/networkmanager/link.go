@@ -358,7 +358,7 @@ func waitLinkCreated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Link); ok {
 		return output, err
@@ -375,7 +375,7 @@ func waitLinkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Link); ok {
 		return output, err
@@ -392,7 +392,7 @@ func waitLinkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Link); ok {
 		return output, err
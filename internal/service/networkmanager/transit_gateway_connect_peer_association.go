@@ -277,7 +277,7 @@ func waitTransitGatewayConnectPeerAssociationCreated(ctx context.Context, conn *
 		Refresh: statusTransitGatewayConnectPeerAssociationState(ctx, conn, globalNetworkID, connectPeerARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayConnectPeerAssociation); ok {
 		return output, err
@@ -294,7 +294,7 @@ func waitTransitGatewayConnectPeerAssociationDeleted(ctx context.Context, conn *
 		Refresh: statusTransitGatewayConnectPeerAssociationState(ctx, conn, globalNetworkID, connectPeerARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayConnectPeerAssociation); ok {
 		return output, err
@@ -397,7 +397,7 @@ func waitVPCAttachmentCreated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: StatusVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -420,7 +420,7 @@ func waitVPCAttachmentAvailable(ctx context.Context, conn *networkmanager.Networ
 		Refresh: StatusVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -437,7 +437,7 @@ func waitVPCAttachmenRejected(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: StatusVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -455,7 +455,7 @@ func waitVPCAttachmentDeleted(ctx context.Context, conn *networkmanager.NetworkM
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -472,7 +472,7 @@ func waitVPCAttachmentUpdated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: StatusVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
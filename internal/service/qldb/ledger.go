@@ -260,7 +260,7 @@ func waitLedgerCreated(ctx context.Context, conn *qldb.Client, name string, time
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qldb.DescribeLedgerOutput); ok {
 		return output, err
@@ -278,7 +278,7 @@ func waitLedgerDeleted(ctx context.Context, conn *qldb.Client, name string, time
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qldb.DescribeLedgerOutput); ok {
 		return output, err
@@ -324,7 +324,7 @@ func waitNamespaceCreated(ctx context.Context, conn *quicksight.QuickSight, id s
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*quicksight.NamespaceInfoV2); ok {
 		return output, err
 	}
@@ -343,7 +343,7 @@ func waitNamespaceDeleted(ctx context.Context, conn *quicksight.QuickSight, id s
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*quicksight.NamespaceInfoV2); ok {
 		return output, err
 	}
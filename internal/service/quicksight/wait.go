@@ -31,7 +31,7 @@ func waitCreated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 		Timeout: dataSourceCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*quicksight.DataSource); ok {
 		if status, errorInfo := aws.StringValue(output.Status), output.ErrorInfo; status == quicksight.ResourceStatusCreationFailed && errorInfo != nil {
@@ -53,7 +53,7 @@ func waitUpdated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 		Timeout: dataSourceUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*quicksight.DataSource); ok {
 		if status, errorInfo := aws.StringValue(output.Status), output.ErrorInfo; status == quicksight.ResourceStatusUpdateFailed && errorInfo != nil {
@@ -76,7 +76,7 @@ func waitTemplateCreated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Template); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -106,7 +106,7 @@ func waitTemplateUpdated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Template); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -136,7 +136,7 @@ func waitDashboardCreated(ctx context.Context, conn *quicksight.QuickSight, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Dashboard); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -166,7 +166,7 @@ func waitDashboardUpdated(ctx context.Context, conn *quicksight.QuickSight, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Dashboard); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -196,7 +196,7 @@ func waitAnalysisCreated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Analysis); ok {
 		if status, apiErrors := aws.StringValue(out.Status), out.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -226,7 +226,7 @@ func waitAnalysisUpdated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Analysis); ok {
 		if status, apiErrors := aws.StringValue(out.Status), out.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -256,7 +256,7 @@ func waitThemeCreated(ctx context.Context, conn *quicksight.QuickSight, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Theme); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -286,7 +286,7 @@ func waitThemeUpdated(ctx context.Context, conn *quicksight.QuickSight, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Theme); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errors *multierror.Error
@@ -207,7 +207,7 @@ func waitForResourceShareResourceAssociation(ctx context.Context, conn *ram.RAM,
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -220,7 +220,7 @@ func WaitForResourceShareResourceDisassociation(ctx context.Context, conn *ram.R
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -25,7 +26,7 @@ func WaitResourceShareInvitationAccepted(ctx context.Context, conn *ram.RAM, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareInvitation); ok {
 		return v, err
@@ -43,7 +44,7 @@ func WaitResourceShareOwnedBySelfDisassociated(ctx context.Context, conn *ram.RA
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -60,7 +61,7 @@ func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, re
 		Timeout: PrincipalAssociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
@@ -77,7 +78,7 @@ func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM,
 		Timeout: PrincipalDisassociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
@@ -176,7 +176,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.RDS, arn string) e
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -191,7 +191,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.RDS, arn string) e
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -199,7 +200,9 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 		// Grab an exclusive lock so that we're only reading one contact flow into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(cevMutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, cevMutexKey); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(cevMutexKey)
 		file, err := resourceCustomDBEngineVersionLoadFileContent(filename)
 		if err != nil {
@@ -360,7 +363,7 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.RDS, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rds.DBEngineVersion); ok {
 		return out, err
 	}
@@ -378,7 +381,7 @@ func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.RDS, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rds.DBEngineVersion); ok {
 		return out, err
 	}
@@ -394,7 +397,7 @@ func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.RDS, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rds.DBEngineVersion); ok {
 		return out, err
 	}
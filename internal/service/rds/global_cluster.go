@@ -500,7 +500,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *rds.RDS, id string, tim
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -514,7 +514,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *rds.RDS, id string, tim
 		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -528,7 +528,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *rds.RDS, id string, tim
 		NotFoundChecks: 1,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -758,7 +758,7 @@ func WaitForClusterUpdate(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
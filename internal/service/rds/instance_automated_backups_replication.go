@@ -276,7 +276,7 @@ func waitDBInstanceAutomatedBackupCreated(ctx context.Context, conn *rds.RDS, ar
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
 		return output, err
@@ -319,7 +319,7 @@ func waitDBInstanceAutomatedBackupDeleted(ctx context.Context, conn *rds.RDS, db
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
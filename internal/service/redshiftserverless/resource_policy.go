@@ -17,7 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -99,27 +99,16 @@ func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("resource_arn", out.ResourceArn)
 
-	doc := resourcePolicyDoc{}
-	log.Printf("policy is %s:", aws.StringValue(out.Policy))
+	policy := aws.StringValue(out.Policy)
 
-	if err := json.Unmarshal([]byte(aws.StringValue(out.Policy)), &doc); err != nil {
-		return sdkdiag.AppendErrorf(diags, "unmarshaling policy: %s", err)
+	if !json.Valid([]byte(policy)) {
+		return sdkdiag.AppendErrorf(diags, "unmarshaling policy: invalid JSON: %s", policy)
 	}
 
-	doc.Statement.Resources = nil
+	// The service adds the resource to the policy's statement.
+	policy = tfjson.RemovePaths(policy, "/Statement/Resource", "/Statement/*/Resource")
 
-	policyDoc := tfiam.IAMPolicyDoc{}
-
-	policyDoc.Id = doc.Id
-	policyDoc.Version = doc.Version
-	policyDoc.Statements = []*tfiam.IAMPolicyStatement{doc.Statement}
-
-	formattedPolicy, err := json.Marshal(policyDoc)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "marshling policy: %s", err)
-	}
-
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), string(formattedPolicy))
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), policy)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
@@ -155,9 +144,3 @@ func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
-
-type resourcePolicyDoc struct {
-	Version   string                    `json:",omitempty"`
-	Id        string                    `json:",omitempty"`
-	Statement *tfiam.IAMPolicyStatement `json:"Statement,omitempty"`
-}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/redshiftserverless"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
@@ -21,7 +22,7 @@ func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
 		return output, err
@@ -42,7 +43,7 @@ func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
 		return output, err
@@ -64,7 +65,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Reds
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.EndpointAccess); ok {
 		return output, err
@@ -83,7 +84,7 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.Red
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.EndpointAccess); ok {
 		return output, err
@@ -104,7 +105,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.Redshif
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Snapshot); ok {
 		return output, err
@@ -123,7 +124,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.RedshiftS
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Snapshot); ok {
 		return output, err
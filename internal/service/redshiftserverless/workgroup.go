@@ -492,7 +492,7 @@ func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Redshi
 		Timeout: wait,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
 		return output, err
@@ -509,7 +509,7 @@ func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: wait,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
 		return output, err
@@ -857,7 +857,7 @@ func WaitForRecordSetToSync(ctx context.Context, conn *route53.Route53, requestI
 			return resourceGoWait(ctx, conn, changeRequest)
 		},
 	}
-	_, err := wait.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &wait)
 	return err
 }
 
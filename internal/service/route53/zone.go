@@ -715,7 +715,7 @@ func waitForChangeSynchronization(ctx context.Context, conn *route53.Route53, ch
 		},
 	}
 
-	_, err := conf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &conf)
 
 	return err
 }
@@ -204,7 +204,7 @@ func waitAutodefinedReverseEnabled(ctx context.Context, conn *route53resolver.Ro
 		Timeout: autodefinedReverseUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverConfig); ok {
 		return output, err
@@ -221,7 +221,7 @@ func waitAutodefinedReverseDisabled(ctx context.Context, conn *route53resolver.R
 		Timeout: autodefinedReverseUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverConfig); ok {
 		return output, err
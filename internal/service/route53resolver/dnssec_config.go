@@ -201,7 +201,7 @@ func waitDNSSECConfigCreated(ctx context.Context, conn *route53resolver.Route53R
 		Timeout: dnssecConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return output, err
@@ -218,7 +218,7 @@ func waitDNSSECConfigDeleted(ctx context.Context, conn *route53resolver.Route53R
 		Timeout: dnssecConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return output, err
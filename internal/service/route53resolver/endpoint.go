@@ -374,7 +374,7 @@ func waitEndpointCreated(ctx context.Context, conn *route53resolver.Route53Resol
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -395,7 +395,7 @@ func waitEndpointUpdated(ctx context.Context, conn *route53resolver.Route53Resol
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -416,7 +416,7 @@ func waitEndpointDeleted(ctx context.Context, conn *route53resolver.Route53Resol
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -260,7 +260,7 @@ func waitFirewallDomainListUpdated(ctx context.Context, conn *route53resolver.Ro
 		Timeout: firewallDomainListUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.FirewallDomainListStatusCompleteImportFailed {
@@ -281,7 +281,7 @@ func waitFirewallDomainListDeleted(ctx context.Context, conn *route53resolver.Ro
 		Timeout: firewallDomainListDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
 		return output, err
@@ -167,7 +167,7 @@ func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53reso
 		Timeout: queryLogConfigAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverQueryLogConfigAssociation); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverQueryLogConfigAssociationStatusFailed {
@@ -188,7 +188,7 @@ func waitQueryLogConfigAssociationDeleted(ctx context.Context, conn *route53reso
 		Timeout: queryLogConfigAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverQueryLogConfigAssociation); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverQueryLogConfigAssociationStatusFailed {
@@ -305,7 +305,7 @@ func waitRuleCreated(ctx context.Context, conn *route53resolver.Route53Resolver,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverRule); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverRuleStatusFailed {
@@ -326,7 +326,7 @@ func waitRuleUpdated(ctx context.Context, conn *route53resolver.Route53Resolver,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverRule); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverRuleStatusFailed {
@@ -347,7 +347,7 @@ func waitRuleDeleted(ctx context.Context, conn *route53resolver.Route53Resolver,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverRule); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverRuleStatusFailed {
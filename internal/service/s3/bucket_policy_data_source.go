@@ -8,8 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKDataSource("aws_s3_bucket_policy", name="Bucket Policy")
@@ -41,7 +41,7 @@ func dataSourceBucketPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("reading S3 Bucket (%s) Policy: %s", name, err)
 	}
 
-	policy, err = structure.NormalizeJsonString(policy)
+	policy, err = verify.CanonicalPolicyToSet(d.Get("policy").(string), policy)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -148,3 +148,26 @@ func objectUpdateTags(ctx context.Context, conn *s3.Client, bucket, key string,
 
 	return nil
 }
+
+// TagConstraints returns the s3 service's constraints on the tags of a single resource.
+// Objects are limited to fewer tags than buckets.
+func (p *servicePackage) TagConstraints() *tftags.Constraints {
+	object := &tftags.Constraints{
+		MaxCount:          10,
+		MaxKeyLength:      128,
+		MaxValueLength:    256,
+		ReservedKeyPrefix: tftags.ReservedKeyPrefixDefault,
+	}
+
+	return &tftags.Constraints{
+		MaxCount:          50,
+		MaxKeyLength:      128,
+		MaxValueLength:    256,
+		ReservedKeyPrefix: tftags.ReservedKeyPrefixDefault,
+		ResourceTypes: map[string]*tftags.Constraints{
+			"aws_s3_bucket_object": object,
+			"aws_s3_object":        object,
+			"aws_s3_object_copy":   object,
+		},
+	}
+}
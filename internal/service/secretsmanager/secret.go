@@ -86,10 +86,7 @@ func resourceSecret() *schema.Resource {
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 			"recovery_window_in_days": {
 				Type:     schema.TypeInt,
@@ -273,7 +270,7 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s) policy: %s", d.Id(), err)
 	} else if v := policy.ResourcePolicy; v != nil {
-		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.ToString(v))
+		policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), aws.ToString(v))
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -92,7 +92,7 @@ func dataSourceSecretRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s) policy: %s", d.Id(), err)
 	} else if v := policy.ResourcePolicy; v != nil {
-		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.ToString(v))
+		policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), aws.ToString(v))
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
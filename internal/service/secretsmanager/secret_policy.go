@@ -45,10 +45,7 @@ func resourceSecretPolicy() *schema.Resource {
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 			"secret_arn": {
 				Type:         schema.TypeString,
@@ -117,7 +114,7 @@ func resourceSecretPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 	// For backwards compatibility we don't check that.
 
 	if output.ResourcePolicy != nil {
-		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.ToString(output.ResourcePolicy))
+		policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), aws.ToString(output.ResourcePolicy))
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -38,7 +38,7 @@ func TestAccSecretsManagerSecretPolicy_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSecretPolicyExists(ctx, resourceName, &policy),
 					resource.TestMatchResourceAttr(resourceName, "policy",
-						regexache.MustCompile(`{"Action":"secretsmanager:GetSecretValue".+`)),
+						regexache.MustCompile(`^{"Version":"2012-10-17","Statement":\[{"Sid":"EnableAllPermissions","Effect":"Allow","Principal":{"AWS":"[^"]+"},"Action":"secretsmanager:GetSecretValue","Resource":"\*"}\]}$`)),
 				),
 			},
 			{
@@ -52,7 +52,7 @@ func TestAccSecretsManagerSecretPolicy_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSecretPolicyExists(ctx, resourceName, &policy),
 					resource.TestMatchResourceAttr(resourceName, "policy",
-						regexache.MustCompile(`{"Action":"secretsmanager:\*".+`)),
+						regexache.MustCompile(`^{"Version":"2012-10-17","Statement":\[{"Sid":"EnableAllPermissions","Effect":"Allow","Principal":{"AWS":"\*"},"Action":"secretsmanager:\*","Resource":"\*"}\]}$`)),
 				),
 			},
 		},
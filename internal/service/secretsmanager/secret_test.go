@@ -344,7 +344,7 @@ func TestAccSecretsManagerSecret_policy(t *testing.T) {
 					testAccCheckSecretExists(ctx, resourceName, &secret),
 					resource.TestCheckResourceAttr(resourceName, "description", "San Holo feat. Duskus"),
 					resource.TestMatchResourceAttr(resourceName, "policy",
-						regexache.MustCompile(`{"Action":"secretsmanager:GetSecretValue".+`)),
+						regexache.MustCompile(`^{"Version":"2012-10-17","Statement":\[{"Sid":"EnableAllPermissions","Effect":"Allow","Principal":{"AWS":"[^"]+"},"Action":"secretsmanager:GetSecretValue","Resource":"\*"}\]}$`)),
 				),
 			},
 			{
@@ -360,7 +360,7 @@ func TestAccSecretsManagerSecret_policy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSecretExists(ctx, resourceName, &secret),
 					resource.TestMatchResourceAttr(resourceName, "policy",
-						regexache.MustCompile(`{"Action":"secretsmanager:GetSecretValue".+`)),
+						regexache.MustCompile(`^{"Version":"2012-10-17","Statement":\[{"Sid":"EnableAllPermissions","Effect":"Allow","Principal":{"AWS":"[^"]+"},"Action":"secretsmanager:GetSecretValue","Resource":"\*"}\]}$`)),
 				),
 			},
 		},
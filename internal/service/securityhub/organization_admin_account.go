@@ -198,7 +198,7 @@ func waitAdminAccountCreated(ctx context.Context, conn *securityhub.Client, admi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AdminAccount); ok {
 		return output, err
@@ -215,7 +215,7 @@ func waitAdminAccountDeleted(ctx context.Context, conn *securityhub.Client, admi
 		Timeout: adminAccountDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AdminAccount); ok {
 		return output, err
@@ -28,7 +28,7 @@ func WaitOperationSuccess(ctx context.Context, conn *servicediscovery.ServiceDis
 		Timeout: OperationSuccessTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicediscovery.Operation); ok {
 		// Error messages can also be contained in the response with FAIL status
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -161,7 +162,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.LogJSONDiff("definition"),
+		),
 	}
 }
 
@@ -270,6 +274,7 @@ func resourceStateMachineRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SFNConn(ctx)
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -326,9 +331,14 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return diag.Errorf("waiting for Step Functions State Machine (%s) update: %s", d.Id(), err)
 		}
+
+		if d.HasChange("definition") {
+			o, n := d.GetChange("definition")
+			diags = verify.AppendJSONDiffWarning(diags, "definition", o.(string), n.(string))
+		}
 	}
 
-	return resourceStateMachineRead(ctx, d, meta)
+	return append(diags, resourceStateMachineRead(ctx, d, meta)...)
 }
 
 func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -399,7 +409,7 @@ func waitStateMachineDeleted(ctx context.Context, conn *sfn.SFN, stateMachineArn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sfn.DescribeStateMachineOutput); ok {
 		return output, err
@@ -298,7 +298,7 @@ func waitApplicationLayerAutomaticResponseCreated(ctx context.Context, conn *shi
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeProtectionOutput); ok {
 		return out, err
 	}
@@ -316,7 +316,7 @@ func waitApplicationLayerAutomaticResponseUpdated(ctx context.Context, conn *shi
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeProtectionOutput); ok {
 		return out, err
 	}
@@ -331,7 +331,7 @@ func waitApplicationLayerAutomaticResponseDeleted(ctx context.Context, conn *shi
 		Refresh: statusApplicationLayerAutomaticResponseDeleted(ctx, conn, resourceArn),
 		Timeout: timeout,
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*shield.DescribeProtectionOutput); ok {
 		return out, err
@@ -262,7 +262,7 @@ func waitDRTAccessLogBucketAssociationCreated(ctx context.Context, conn *shield.
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeDRTAccessOutput); ok {
 		return out, err
 	}
@@ -280,7 +280,7 @@ func waitDRTAccessLogBucketAssociationUpdated(ctx context.Context, conn *shield.
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeDRTAccessOutput); ok {
 		return out, err
 	}
@@ -296,7 +296,7 @@ func waitDRTAccessLogBucketAssociationDeleted(ctx context.Context, conn *shield.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeDRTAccessOutput); ok {
 		return out, err
 	}
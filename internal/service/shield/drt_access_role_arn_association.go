@@ -248,7 +248,7 @@ func waitDRTAccessRoleARNAssociationCreated(ctx context.Context, conn *shield.Cl
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeDRTAccessOutput); ok {
 		return out, err
 	}
@@ -266,7 +266,7 @@ func waitDRTAccessRoleARNAssociationUpdated(ctx context.Context, conn *shield.Cl
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*shield.DescribeDRTAccessOutput); ok {
 		return out, err
 	}
@@ -282,7 +282,7 @@ func waitDRTAccessRoleARNAssociationDeleted(ctx context.Context, conn *shield.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*shield.DescribeDRTAccessOutput); ok {
 		return out, err
@@ -92,7 +92,9 @@ func resourceSigningProfilePermissionCreate(ctx context.Context, d *schema.Resou
 
 	profileName := d.Get("profile_name").(string)
 
-	conns.GlobalMutexKV.Lock(profileName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, profileName); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(profileName)
 
 	var revisionID string
@@ -183,7 +185,9 @@ func resourceSigningProfilePermissionDelete(ctx context.Context, d *schema.Resou
 
 	profileName := d.Get("profile_name").(string)
 
-	conns.GlobalMutexKV.Lock(profileName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, profileName); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(profileName)
 
 	output, err := conn.ListProfilePermissions(ctx, &signer.ListProfilePermissionsInput{
@@ -170,10 +170,7 @@ var (
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 			DiffSuppressOnRefresh: true,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
+			StateFunc:             verify.CanonicalPolicyStateFunc,
 		},
 		"signature_version": {
 			Type:         schema.TypeInt,
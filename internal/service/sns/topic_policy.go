@@ -50,10 +50,7 @@ func resourceTopicPolicy() *schema.Resource {
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             verify.CanonicalPolicyStateFunc,
 			},
 		},
 	}
@@ -109,7 +106,7 @@ func resourceTopicPolicyRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", attributes[topicAttributeNameTopicARN])
 	d.Set("owner", attributes[topicAttributeNameOwner])
 
-	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+	policyToSet, err := verify.CanonicalPolicyToSet(d.Get("policy").(string), policy)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -407,7 +407,7 @@ func waitSubscriptionConfirmed(ctx context.Context, conn *sns.Client, arn string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(map[string]string); ok {
 		return output, err
@@ -424,7 +424,7 @@ func waitSubscriptionDeleted(ctx context.Context, conn *sns.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(map[string]string); ok {
 		return output, err
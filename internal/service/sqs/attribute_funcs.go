@@ -83,7 +83,7 @@ func (h *queueAttributeHandler) Read(ctx context.Context, d *schema.ResourceData
 	}
 
 	if h.SchemaKey == "policy" {
-		newValue, err = verify.PolicyToSet(d.Get("policy").(string), newValue)
+		newValue, err = verify.CanonicalPolicyToSet(d.Get("policy").(string), newValue)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -116,10 +116,7 @@ var (
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 			DiffSuppressOnRefresh: true,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
+			StateFunc:             verify.CanonicalPolicyStateFunc,
 		},
 		"receive_wait_time_seconds": {
 			Type:     schema.TypeInt,
@@ -579,7 +576,7 @@ func waitQueueAttributesPropagated(ctx context.Context, conn *sqs.Client, url st
 		NotFoundChecks:            10,              // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -595,7 +592,7 @@ func waitQueueDeleted(ctx context.Context, conn *sqs.Client, url string) error {
 		NotFoundChecks:            5,               // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
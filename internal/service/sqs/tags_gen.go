@@ -135,3 +135,13 @@ func updateTags(ctx context.Context, conn *sqs.Client, identifier string, oldTag
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
 	return updateTags(ctx, meta.(*conns.AWSClient).SQSClient(ctx), identifier, oldTags, newTags)
 }
+
+// TagConstraints returns the sqs service's constraints on the tags of a single resource.
+func (p *servicePackage) TagConstraints() *tftags.Constraints {
+	return &tftags.Constraints{
+		MaxCount:          50,
+		MaxKeyLength:      128,
+		MaxValueLength:    256,
+		ReservedKeyPrefix: "aws:",
+	}
+}
@@ -603,7 +603,7 @@ func waitDocumentActive(ctx context.Context, conn *ssm.SSM, name string) (*ssm.D
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusInformation)))
@@ -625,7 +625,7 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.SSM, name string) (*ssm.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusInformation)))
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -34,7 +35,7 @@ func waitGatewayConnected(ctx context.Context, conn *storagegateway.StorageGatew
 		ContinuousTargetOccurence: gatewayConnectedContinuousTargetOccurence, // Gateway activations can take a few seconds and can trigger a reboot of the Gateway
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	switch output := outputRaw.(type) {
 	case *storagegateway.DescribeGatewayInformationOutput:
@@ -52,7 +53,7 @@ func waitGatewayJoinDomainJoined(ctx context.Context, conn *storagegateway.Stora
 		Timeout: gatewayJoinDomainJoinedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeSMBSettingsOutput); ok {
 		return output, err
@@ -70,7 +71,7 @@ func waitStorediSCSIVolumeAvailable(ctx context.Context, conn *storagegateway.St
 		Timeout: storediSCSIVolumeAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeStorediSCSIVolumesOutput); ok {
 		return output, err
@@ -88,7 +89,7 @@ func waitNFSFileShareCreated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   nfsFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -107,7 +108,7 @@ func waitNFSFileShareDeleted(ctx context.Context, conn *storagegateway.StorageGa
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -125,7 +126,7 @@ func waitNFSFileShareUpdated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   nfsFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -143,7 +144,7 @@ func waitSMBFileShareCreated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   smbFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -162,7 +163,7 @@ func waitSMBFileShareDeleted(ctx context.Context, conn *storagegateway.StorageGa
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -180,7 +181,7 @@ func waitSMBFileShareUpdated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   smbFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -198,7 +199,7 @@ func waitFileSystemAssociationAvailable(ctx context.Context, conn *storagegatewa
 		Delay:   fileSystemAssociationAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
 		return output, err
@@ -217,7 +218,7 @@ func waitFileSystemAssociationDeleted(ctx context.Context, conn *storagegateway.
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
 		return output, err
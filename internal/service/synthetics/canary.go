@@ -285,7 +285,7 @@ func resourceCanaryCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Tags:               getTagsIn(ctx),
 	}
 
-	if code, err := expandCanaryCode(d); err != nil {
+	if code, err := expandCanaryCode(ctx, d); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Synthetics Canary (%s): %s", name, err)
 	} else {
 		input.Code = code
@@ -446,7 +446,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.HasChanges("handler", "zip_file", "s3_bucket", "s3_key", "s3_version") {
-			if code, err := expandCanaryCode(d); err != nil {
+			if code, err := expandCanaryCode(ctx, d); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
 			} else {
 				input.Code = code
@@ -563,13 +563,15 @@ func resourceCanaryDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
-func expandCanaryCode(d *schema.ResourceData) (*awstypes.CanaryCodeInput, error) {
+func expandCanaryCode(ctx context.Context, d *schema.ResourceData) (*awstypes.CanaryCodeInput, error) {
 	codeConfig := &awstypes.CanaryCodeInput{
 		Handler: aws.String(d.Get("handler").(string)),
 	}
 
 	if v, ok := d.GetOk("zip_file"); ok {
-		conns.GlobalMutexKV.Lock(canaryMutex)
+		if err := conns.GlobalMutexKV.LockContext(ctx, canaryMutex); err != nil {
+			return nil, err
+		}
 		defer conns.GlobalMutexKV.Unlock(canaryMutex)
 		file, err := loadFileContent(v.(string))
 		if err != nil {
@@ -225,7 +225,7 @@ func waitVocabularyCreated(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -247,7 +247,7 @@ func waitVocabularyUpdated(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -267,7 +267,7 @@ func waitVocabularyDeleted(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
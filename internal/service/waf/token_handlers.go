@@ -22,7 +22,9 @@ type WafRetryer struct {
 type withTokenFunc func(token *string) (interface{}, error)
 
 func (t *WafRetryer) RetryWithToken(ctx context.Context, f withTokenFunc) (interface{}, error) {
-	conns.GlobalMutexKV.Lock("WafRetryer")
+	if err := conns.GlobalMutexKV.LockContext(ctx, "WafRetryer"); err != nil {
+		return nil, err
+	}
 	defer conns.GlobalMutexKV.Unlock("WafRetryer")
 
 	var out interface{}
@@ -24,7 +24,9 @@ type WafRegionalRetryer struct {
 type withRegionalTokenFunc func(token *string) (interface{}, error)
 
 func (t *WafRegionalRetryer) RetryWithToken(ctx context.Context, f withRegionalTokenFunc) (interface{}, error) {
-	conns.GlobalMutexKV.Lock(t.Region)
+	if err := conns.GlobalMutexKV.LockContext(ctx, t.Region); err != nil {
+		return nil, err
+	}
 	defer conns.GlobalMutexKV.Unlock(t.Region)
 
 	var out interface{}
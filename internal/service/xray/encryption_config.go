@@ -140,7 +140,7 @@ func waitEncryptionConfigAvailable(ctx context.Context, conn *xray.Client) (*typ
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.EncryptionConfig); ok {
 		return output, err
@@ -7,6 +7,14 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type contextKeyType int
+
+const (
+	regionContextKey contextKeyType = iota
+	limiterContextKey
 )
 
 func Context(region string) context.Context {
@@ -16,5 +24,39 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+// regionFromContext returns the sweeper's Region stored in Context, if any.
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+	return v
+}
+
+// resourceTypeFromContext returns the sweeper's resource type stored in Context, if any.
+func resourceTypeFromContext(ctx context.Context) string {
+	if v, ok := conns.FromContext(ctx); ok {
+		return v.TypeName
+	}
+
+	return ""
+}
+
+// withResourceType stores the sweeper's resource type in Context.
+// It is stored as the provider's resource wrappers do so that the sweep/sdk and sweep/framework packages can find it.
+func withResourceType(ctx context.Context, resourceType string) context.Context {
+	return conns.NewResourceContext(ctx, "", "", resourceType)
+}
+
+// limiterFromContext returns the service limiter stored in Context, if any.
+func limiterFromContext(ctx context.Context) (*limiter, bool) {
+	v, ok := ctx.Value(limiterContextKey).(*limiter)
+	return v, ok
+}
+
+// withLimiter stores the service limiter in Context.
+func withLimiter(ctx context.Context, l *limiter) context.Context {
+	return context.WithValue(ctx, limiterContextKey, l)
+}
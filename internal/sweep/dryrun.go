@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const readOnlyGuardID = "TerraformAWSProvider:SweeperDryRun"
+
+// DryRun returns whether or not sweepers are running in dry-run mode.
+// In dry-run mode no resources are deleted. Instead, an inventory of the resources that would be deleted is written.
+func DryRun() bool {
+	return os.Getenv(envvar.SweepDryRun) != ""
+}
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes permitted in dry-run mode.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func errNotReadOnlyOperation(name string) error {
+	return fmt.Errorf("sweeper dry-run: AWS API operation %s is not permitted", name)
+}
+
+// addReadOnlyGuardSDKv1 prevents AWS SDK for Go v1 API clients from making any call that is not read-only.
+// This protects against sweepers that delete resources without using SweepOrchestrator.
+func addReadOnlyGuardSDKv1(handlers *request_sdkv1.Handlers) {
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: readOnlyGuardID,
+		Fn: func(r *request_sdkv1.Request) {
+			if name := r.Operation.Name; !isReadOnlyOperation(name) {
+				r.Error = errNotReadOnlyOperation(name)
+			}
+		},
+	})
+}
+
+// addReadOnlyGuardSDKv2 prevents AWS SDK for Go v2 API clients from making any call that is not read-only.
+// This protects against sweepers that delete resources without using SweepOrchestrator.
+func addReadOnlyGuardSDKv2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		readOnlyGuardID,
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if name := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(name) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, errNotReadOnlyOperation(name)
+			}
+
+			return next.HandleInitialize(ctx, in)
+		},
+	), middleware.Before)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package filter implements the common resource filter applied by sweepers before deleting a resource.
+// The filter is configured by environment variables so that sweepers can be run safely in AWS accounts
+// shared with long-lived infrastructure.
+package filter
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Attribute names searched, in order, for a resource's name, tags and creation time.
+var (
+	nameAttributes = []string{
+		"name",
+		"id",
+	}
+	tagsAttributes = []string{
+		"tags_all",
+		"tags",
+	}
+	creationTimeAttributes = []string{
+		"creation_date",
+		"created_date",
+		"create_date",
+		"creation_time",
+		"created_time",
+		"create_time",
+		"created_at",
+		"creation_timestamp",
+		"created_timestamp",
+	}
+)
+
+// Filter restricts the resources deleted by sweepers.
+// A resource is swept only if it matches every configured criterion.
+type Filter struct {
+	// Tags is the set of tags that a resource must have.
+	// An empty value matches any value for the tag key.
+	Tags map[string]string
+	// NamePrefixes is the set of name prefixes, one of which a resource's name must start with.
+	NamePrefixes []string
+	// MinAge is the minimum time since a resource's creation.
+	// Resources with an unknown creation time are not swept.
+	MinAge time.Duration
+}
+
+// FromEnv returns the Filter configured by environment variables.
+// A nil Filter is returned if no filter is configured.
+func FromEnv() (*Filter, error) {
+	f := &Filter{}
+	configured := false
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		f.Tags = make(map[string]string)
+		for _, pair := range strings.Split(v, ",") {
+			key, value, _ := strings.Cut(pair, "=")
+			key = strings.TrimSpace(key)
+			if key == "" {
+				return nil, fmt.Errorf("invalid %s value (%s): empty tag key", envvar.SweepTags, v)
+			}
+			f.Tags[key] = strings.TrimSpace(value)
+		}
+		configured = true
+	}
+
+	if v := os.Getenv(envvar.SweepNamePrefixes); v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				f.NamePrefixes = append(f.NamePrefixes, prefix)
+			}
+		}
+		configured = true
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value (%s): %w", envvar.SweepMinAge, v, err)
+		}
+		f.MinAge = d
+		configured = true
+	}
+
+	if !configured {
+		return nil, nil
+	}
+
+	return f, nil
+}
+
+// Match returns whether or not the resource with the specified flattened attributes matches the filter.
+// Attributes are in flatmap format, e.g. "tags_all.Name". If the resource does not match, the reason is returned.
+func (f *Filter) Match(attributes map[string]string, now time.Time) (bool, string) {
+	if f == nil {
+		return true, ""
+	}
+
+	if len(f.Tags) > 0 {
+		tags := tagsFromAttributes(attributes)
+		for key, value := range f.Tags {
+			v, ok := tags[key]
+			if !ok {
+				return false, fmt.Sprintf("tag %q not set", key)
+			}
+			if value != "" && v != value {
+				return false, fmt.Sprintf("tag %q value %q does not match %q", key, v, value)
+			}
+		}
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := firstAttribute(attributes, nameAttributes)
+		matched := false
+		for _, prefix := range f.NamePrefixes {
+			if strings.HasPrefix(name, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, fmt.Sprintf("name %q does not match any prefix", name)
+		}
+	}
+
+	if f.MinAge > 0 {
+		createdAt, ok := creationTimeFromAttributes(attributes)
+		if !ok {
+			return false, "creation time unknown"
+		}
+		if age := now.Sub(createdAt); age < f.MinAge {
+			return false, fmt.Sprintf("age %s less than %s", age.Truncate(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+func firstAttribute(attributes map[string]string, names []string) string {
+	for _, name := range names {
+		if v := attributes[name]; v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func tagsFromAttributes(attributes map[string]string) map[string]string {
+	for _, name := range tagsAttributes {
+		prefix := name + "."
+		tags := make(map[string]string)
+		for k, v := range attributes {
+			if key, ok := strings.CutPrefix(k, prefix); ok && key != "%" {
+				tags[key] = v
+			}
+		}
+		if len(tags) > 0 {
+			return tags
+		}
+	}
+
+	return nil
+}
+
+func creationTimeFromAttributes(attributes map[string]string) (time.Time, bool) {
+	v := firstAttribute(attributes, creationTimeAttributes)
+	if v == "" {
+		return time.Time{}, false
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// SetTags sets the specified tags in flattened attributes, replacing any existing tags.
+func SetTags(attributes map[string]string, tags map[string]string) {
+	for k := range attributes {
+		for _, name := range tagsAttributes {
+			if strings.HasPrefix(k, name+".") {
+				delete(attributes, k)
+			}
+		}
+	}
+
+	attributes["tags_all.%"] = strconv.Itoa(len(tags))
+	for k, v := range tags {
+		attributes["tags_all."+k] = v
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		env       map[string]string
+		want      *Filter
+		wantError bool
+	}{
+		"not configured": {},
+		"tags": {
+			env: map[string]string{
+				envvar.SweepTags: "Owner=tf-acc, Ephemeral",
+			},
+			want: &Filter{
+				Tags: map[string]string{
+					"Owner":     "tf-acc",
+					"Ephemeral": "",
+				},
+			},
+		},
+		"empty tag key": {
+			env: map[string]string{
+				envvar.SweepTags: "Owner=tf-acc,=x",
+			},
+			wantError: true,
+		},
+		"name prefixes and min age": {
+			env: map[string]string{
+				envvar.SweepNamePrefixes: "tf-acc-test-,tf-test-,",
+				envvar.SweepMinAge:       "24h",
+			},
+			want: &Filter{
+				NamePrefixes: []string{"tf-acc-test-", "tf-test-"},
+				MinAge:       24 * time.Hour,
+			},
+		},
+		"invalid min age": {
+			env: map[string]string{
+				envvar.SweepMinAge: "1 day",
+			},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{envvar.SweepTags, envvar.SweepNamePrefixes, envvar.SweepMinAge} {
+				t.Setenv(k, testCase.env[k])
+			}
+
+			got, err := FromEnv()
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("FromEnv() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter     *Filter
+		attributes map[string]string
+		want       bool
+	}{
+		"nil filter": {
+			attributes: map[string]string{
+				"id": "test",
+			},
+			want: true,
+		},
+		"tag matches": {
+			filter: &Filter{
+				Tags: map[string]string{"Owner": "tf-acc"},
+			},
+			attributes: map[string]string{
+				"tags_all.%":     "2",
+				"tags_all.Owner": "tf-acc",
+				"tags_all.Name":  "test",
+			},
+			want: true,
+		},
+		"tag matches any value": {
+			filter: &Filter{
+				Tags: map[string]string{"Ephemeral": ""},
+			},
+			attributes: map[string]string{
+				"tags.%":         "1",
+				"tags.Ephemeral": "true",
+			},
+			want: true,
+		},
+		"tag value mismatch": {
+			filter: &Filter{
+				Tags: map[string]string{"Owner": "tf-acc"},
+			},
+			attributes: map[string]string{
+				"tags_all.%":     "1",
+				"tags_all.Owner": "platform",
+			},
+		},
+		"tag not set": {
+			filter: &Filter{
+				Tags: map[string]string{"Owner": "tf-acc"},
+			},
+			attributes: map[string]string{
+				"id": "test",
+			},
+		},
+		"name prefix matches": {
+			filter: &Filter{
+				NamePrefixes: []string{"tf-acc-test-", "tf-test-"},
+			},
+			attributes: map[string]string{
+				"id":   "i-1234",
+				"name": "tf-test-1234",
+			},
+			want: true,
+		},
+		"name prefix matches id": {
+			filter: &Filter{
+				NamePrefixes: []string{"tf-acc-test-"},
+			},
+			attributes: map[string]string{
+				"id": "tf-acc-test-1234",
+			},
+			want: true,
+		},
+		"name prefix mismatch": {
+			filter: &Filter{
+				NamePrefixes: []string{"tf-acc-test-"},
+			},
+			attributes: map[string]string{
+				"name": "production",
+			},
+		},
+		"old enough": {
+			filter: &Filter{
+				MinAge: 24 * time.Hour,
+			},
+			attributes: map[string]string{
+				"creation_date": "2024-02-28T12:00:00Z",
+			},
+			want: true,
+		},
+		"too new": {
+			filter: &Filter{
+				MinAge: 24 * time.Hour,
+			},
+			attributes: map[string]string{
+				"created_at": "2024-03-01T00:00:00Z",
+			},
+		},
+		"creation time unknown": {
+			filter: &Filter{
+				MinAge: 24 * time.Hour,
+			},
+			attributes: map[string]string{
+				"id": "test",
+			},
+		},
+		"all criteria": {
+			filter: &Filter{
+				Tags:         map[string]string{"Owner": "tf-acc"},
+				NamePrefixes: []string{"tf-acc-test-"},
+				MinAge:       time.Hour,
+			},
+			attributes: map[string]string{
+				"name":           "tf-acc-test-1234",
+				"tags_all.%":     "1",
+				"tags_all.Owner": "tf-acc",
+				"create_time":    "2024-03-01T10:00:00.123Z",
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.Match(testCase.attributes, now)
+
+			if got != testCase.want {
+				t.Errorf("Match() = %t (%s), want %t", got, reason, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSetTags(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]string{
+		"id":           "test",
+		"tags.%":       "1",
+		"tags.Old":     "old",
+		"tags_all.%":   "1",
+		"tags_all.Old": "old",
+	}
+
+	SetTags(attributes, map[string]string{"New": "new"})
+
+	want := map[string]string{
+		"id":           "test",
+		"tags_all.%":   "1",
+		"tags_all.New": "new",
+	}
+
+	if diff := cmp.Diff(attributes, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Skip returns whether or not the resource is excluded from sweeping by the configured filter.
+// If a filter is configured the resource is first read to obtain its current name, tags and creation time.
+func (sr *sweepResource) Skip(ctx context.Context) (bool, error) {
+	f, err := filter.FromEnv()
+
+	if err != nil {
+		return false, err
+	}
+
+	if f == nil {
+		return false, nil
+	}
+
+	ctx, resource, state, err := sr.newState(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	// Transparently tagged resources set their tags in Context.
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return false, err
+	}
+
+	if response.State.Raw.IsNull() {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": "not found",
+		})
+		return true, nil
+	}
+
+	attributes, err := flattenState(response.State.Raw)
+
+	if err != nil {
+		return false, err
+	}
+
+	typeName := resourceMetadata(ctx, resource).TypeName
+	if tags, err := sr.listTags(ctx, typeName, attributes); err != nil {
+		return false, err
+	} else if tags != nil {
+		filter.SetTags(attributes, tags)
+	}
+
+	if ok, reason := f.Match(attributes, time.Now()); !ok {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
+		})
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// listTags returns the tags of a transparently tagged resource.
+// Tags set in Context by the resource's Read handler are used if present, otherwise the service package's ListTags is called.
+// nil is returned if the resource is not transparently tagged.
+func (sr *sweepResource) listTags(ctx context.Context, typeName string, attributes map[string]string) (map[string]string, error) {
+	inContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	if inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.MustUnwrap().Map(), nil
+	}
+
+	registration, ok := lookupRegistration(ctx, sr.meta, typeName)
+	if !ok || registration.resource.Tags == nil {
+		return nil, nil
+	}
+
+	spt := registration.resource.Tags
+	identifier := attributes[spt.IdentifierAttribute]
+
+	if identifier == "" {
+		return nil, nil
+	}
+
+	var err error
+	if v, ok := registration.servicePackage.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = v.ListTags(ctx, sr.meta, identifier) // Sets tags in Context
+	} else if v, ok := registration.servicePackage.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && spt.ResourceType != "" {
+		err = v.ListTags(ctx, sr.meta, identifier, spt.ResourceType) // Sets tags in Context
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.MustUnwrap().Map(), nil
+	}
+
+	return nil, nil
+}
+
+// resourceRegistration is a resource's registration with its service package.
+type resourceRegistration struct {
+	servicePackage conns.ServicePackage
+	resource       *types.ServicePackageFrameworkResource
+}
+
+var (
+	registrationsOnce sync.Once
+	registrations     map[string]resourceRegistration
+)
+
+// lookupRegistration returns the service package registration of the resource with the specified type name, if known.
+func lookupRegistration(ctx context.Context, meta *conns.AWSClient, typeName string) (resourceRegistration, bool) {
+	if meta == nil {
+		return resourceRegistration{}, false
+	}
+
+	registrationsOnce.Do(func() {
+		registrations = make(map[string]resourceRegistration)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.FrameworkResources(ctx) {
+				resource, err := v.Factory(ctx)
+				if err != nil {
+					continue
+				}
+
+				registrations[resourceMetadata(ctx, resource).TypeName] = resourceRegistration{
+					servicePackage: sp,
+					resource:       v,
+				}
+			}
+		}
+	})
+
+	v, ok := registrations[typeName]
+
+	return v, ok
+}
+
+// flattenState returns the top-level primitive and string map attributes of a resource's state in flatmap format.
+func flattenState(raw tftypes.Value) (map[string]string, error) {
+	var values map[string]tftypes.Value
+
+	if err := raw.As(&values); err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+
+	for k, v := range values {
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		switch typ := v.Type(); {
+		case typ.Is(tftypes.String):
+			var s string
+			if err := v.As(&s); err != nil {
+				return nil, err
+			}
+			attributes[k] = s
+		case typ.Is(tftypes.Number):
+			var n big.Float
+			if err := v.As(&n); err != nil {
+				return nil, err
+			}
+			attributes[k] = n.Text('f', -1)
+		case typ.Is(tftypes.Bool):
+			var b bool
+			if err := v.As(&b); err != nil {
+				return nil, err
+			}
+			attributes[k] = strconv.FormatBool(b)
+		case typ.Is(tftypes.Map{ElementType: tftypes.String}):
+			var m map[string]tftypes.Value
+			if err := v.As(&m); err != nil {
+				return nil, err
+			}
+			n := 0
+			for mk, mv := range m {
+				var s string
+				if !mv.IsKnown() || mv.IsNull() {
+					continue
+				}
+				if err := mv.As(&s); err != nil {
+					return nil, err
+				}
+				attributes[k+"."+mk] = s
+				n++
+			}
+			attributes[k+".%"] = strconv.Itoa(n)
+		}
+	}
+
+	return attributes, nil
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -49,31 +50,16 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
-
-	if err != nil {
+	if skip, err := sr.Skip(ctx); err != nil {
 		return err
+	} else if skip {
+		return nil
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
-
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
+	ctx, resource, state, err := sr.newState(ctx)
 
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	if err != nil {
+		return err
 	}
 
 	tflog.Info(ctx, "Sweeping resource")
@@ -118,6 +104,55 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the resource's Terraform type name and its identifying attributes.
+func (sr *sweepResource) Describe(ctx context.Context) (string, map[string]string, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	attributes := make(map[string]string, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		attributes[attr.path] = fmt.Sprint(attr.value)
+	}
+
+	return resourceMetadata(ctx, resource).TypeName, attributes, nil
+}
+
+// newState returns a configured resource and its state containing the sweeper's identifying attributes.
+// The returned Context has the resource type and identifying attributes set as logging fields.
+func (sr *sweepResource) newState(ctx context.Context) (context.Context, fwresource.Resource, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return ctx, nil, tfsdk.State{}, err
+	}
+
+	metadata := resourceMetadata(ctx, resource)
+	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return ctx, resource, state, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const defaultInventoryFile = "sweeper-inventory.json"
+
+// Describable is implemented by Sweepables that can describe the resource they delete.
+type Describable interface {
+	// Describe returns the resource's Terraform type name, if known, and its identifying attributes.
+	Describe(ctx context.Context) (string, map[string]string, error)
+}
+
+// Filterable is implemented by Sweepables that apply the configured sweeper filter.
+type Filterable interface {
+	// Skip returns whether or not the resource is excluded from sweeping by the configured filter.
+	Skip(ctx context.Context) (bool, error)
+}
+
+// inventoryEntry is the set of resources of a single type that would be deleted.
+type inventoryEntry struct {
+	ResourceType string              `json:"resource_type"`
+	Resources    []map[string]string `json:"resources"`
+}
+
+// inventory is the set of resources that would be deleted, grouped by Region and resource type.
+// Within a Region, resource types are in the order in which they were swept, so dependencies come before their dependents.
+type inventory struct {
+	lock    sync.Mutex
+	regions map[string][]*inventoryEntry
+}
+
+func newInventory() *inventory {
+	return &inventory{
+		regions: make(map[string][]*inventoryEntry),
+	}
+}
+
+// add adds the specified resources to the inventory.
+func (inv *inventory) add(region, resourceType string, resources ...map[string]string) {
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	var entry *inventoryEntry
+	for _, v := range inv.regions[region] {
+		if v.ResourceType == resourceType {
+			entry = v
+			break
+		}
+	}
+
+	if entry == nil {
+		entry = &inventoryEntry{
+			ResourceType: resourceType,
+			Resources:    make([]map[string]string, 0),
+		}
+		inv.regions[region] = append(inv.regions[region], entry)
+	}
+
+	entry.Resources = append(entry.Resources, resources...)
+}
+
+func (inv *inventory) MarshalJSON() ([]byte, error) {
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	return json.Marshal(inv.regions)
+}
+
+// writeFile writes the inventory as JSON to the specified file.
+func (inv *inventory) writeFile(name string) error {
+	b, err := json.MarshalIndent(inv, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, b, 0644)
+}
+
+var sweeperInventory = newInventory()
+
+// recordInventory adds the resources that would be deleted by the specified Sweepables to the inventory and writes the inventory file.
+// The file is rewritten after each sweeper so that it is complete even if a later sweeper fails.
+func recordInventory(ctx context.Context, sweepables []Sweepable) error {
+	region := regionFromContext(ctx)
+	resourceType := resourceTypeFromContext(ctx)
+
+	for _, sweepable := range sweepables {
+		if v, ok := sweepable.(Filterable); ok {
+			skip, err := v.Skip(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			if skip {
+				continue
+			}
+		}
+
+		var typeName string
+		var attributes map[string]string
+
+		if v, ok := sweepable.(Describable); ok {
+			var err error
+			typeName, attributes, err = v.Describe(ctx)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if typeName == "" {
+			typeName = resourceType
+		}
+
+		if attributes == nil {
+			attributes = make(map[string]string)
+		}
+
+		tflog.Info(ctx, "Would sweep resource", map[string]any{
+			"resource_type": typeName,
+			"attributes":    attributes,
+		})
+
+		sweeperInventory.add(region, typeName, attributes)
+	}
+
+	return sweeperInventory.writeFile(envvar.GetWithDefault(envvar.SweepInventoryFile, defaultInventoryFile))
+}
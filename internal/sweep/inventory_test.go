@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	typeName   string
+	attributes map[string]string
+	deleted    bool
+}
+
+func (s *testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	s.deleted = true
+	return errors.New("unexpected Delete")
+}
+
+func (s *testSweepable) Describe(context.Context) (string, map[string]string, error) {
+	return s.typeName, s.attributes, nil
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "inventory.json")
+	t.Setenv(envvar.SweepDryRun, "1")
+	t.Setenv(envvar.SweepInventoryFile, name)
+
+	sweeperInventory = newInventory()
+	t.Cleanup(func() {
+		sweeperInventory = newInventory()
+	})
+
+	subnet := &testSweepable{typeName: "aws_subnet", attributes: map[string]string{"id": "subnet-1"}}
+	vpc1 := &testSweepable{typeName: "aws_vpc", attributes: map[string]string{"id": "vpc-1"}}
+	vpc2 := &testSweepable{attributes: map[string]string{"id": "vpc-2"}}
+	queue := &testSweepable{typeName: "aws_sqs_queue", attributes: map[string]string{"id": "https://sqs/queue"}}
+
+	// Dependencies are swept first.
+	ctx := Context("us-west-2") //lintignore:AWSAT003
+	if err := SweepOrchestrator(ctx, []Sweepable{subnet}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SweepOrchestrator(withResourceType(ctx, "aws_vpc"), []Sweepable{vpc1, vpc2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SweepOrchestrator(Context("us-east-1"), []Sweepable{queue}); err != nil { //lintignore:AWSAT003
+		t.Fatal(err)
+	}
+
+	for _, v := range []*testSweepable{subnet, vpc1, vpc2, queue} {
+		if v.deleted {
+			t.Errorf("resource %s deleted", v.attributes["id"])
+		}
+	}
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string][]inventoryEntry
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]inventoryEntry{
+		"us-east-1": { //lintignore:AWSAT003
+			{ResourceType: "aws_sqs_queue", Resources: []map[string]string{{"id": "https://sqs/queue"}}},
+		},
+		"us-west-2": { //lintignore:AWSAT003
+			{ResourceType: "aws_subnet", Resources: []map[string]string{{"id": "subnet-1"}}},
+			{ResourceType: "aws_vpc", Resources: []map[string]string{{"id": "vpc-1"}, {"id": "vpc-2"}}},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeVpcs":      true,
+		"GetCallerIdentity": true,
+		"ListBuckets":       true,
+		"HeadObject":        true,
+		"DeleteVpc":         false,
+		"PutBucketPolicy":   false,
+		"TerminateInstance": false,
+		"UpdateTable":       false,
+	}
+
+	for name, want := range testCases {
+		name, want := name, want
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isReadOnlyOperation(name); got != want {
+				t.Errorf("isReadOnlyOperation(%q) = %t, want %t", name, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	// defaultConcurrency is the default maximum number of resources deleted concurrently by a SweepOrchestrator.
+	defaultConcurrency = 20
+
+	// minBackoff and maxBackoff bound the time for which new deletions are paused after throttling is observed.
+	minBackoff = 1 * time.Second
+	maxBackoff = 30 * time.Second
+
+	throttleObserverID = "TerraformAWSProvider:SweeperThrottleObserver"
+)
+
+// limiter bounds the number of concurrent deletions for a single service.
+// The limit adapts to throttling: it is halved, and new deletions are paused for an exponentially increasing period,
+// each time throttling is observed and it is increased by one after each limit's worth of consecutive successes.
+// It is safe for concurrent use.
+type limiter struct {
+	lock         sync.Mutex
+	changed      chan struct{} // Closed and replaced whenever a slot is released or the limit is changed.
+	maxLimit     int
+	limit        int
+	inUse        int
+	successes    int
+	backoff      time.Duration
+	backoffUntil time.Time
+}
+
+func newLimiter(limit int) *limiter {
+	limit = max(limit, 1)
+
+	return &limiter{
+		changed:  make(chan struct{}),
+		maxLimit: limit,
+		limit:    limit,
+	}
+}
+
+// acquire blocks until a deletion may start or Context is done.
+func (l *limiter) acquire(ctx context.Context) error {
+	var err error
+
+	for {
+		l.lock.Lock()
+		wait := time.Until(l.backoffUntil)
+		if wait <= 0 && l.inUse < l.limit {
+			l.inUse++
+			l.lock.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.lock.Unlock()
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			expired = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-changed:
+		case <-expired:
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// release ends a deletion started by acquire.
+func (l *limiter) release(success bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.inUse--
+	if success {
+		l.successes++
+		if l.successes >= l.limit {
+			l.successes = 0
+			l.backoff = 0
+			l.limit = min(l.limit+1, l.maxLimit)
+		}
+	}
+	l.notify()
+}
+
+// throttled records that throttling was observed.
+// Repeated throttling during a backoff period, typically from concurrent deletions, is treated as a single event.
+func (l *limiter) throttled() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	if now.Before(l.backoffUntil) {
+		return
+	}
+
+	l.successes = 0
+	l.limit = max(l.limit/2, 1)
+	l.backoff = min(max(l.backoff*2, minBackoff), maxBackoff)
+	l.backoffUntil = now.Add(l.backoff)
+	l.notify()
+}
+
+// currentLimit returns the current concurrency limit.
+func (l *limiter) currentLimit() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.limit
+}
+
+func (l *limiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+var (
+	limitersLock sync.Mutex
+	limiters     = make(map[string]*limiter)
+)
+
+// serviceLimiter returns the shared limiter for the specified service.
+func serviceLimiter(service string) (*limiter, error) {
+	limitersLock.Lock()
+	defer limitersLock.Unlock()
+
+	if l, ok := limiters[service]; ok {
+		return l, nil
+	}
+
+	limits, err := serviceConcurrency()
+	if err != nil {
+		return nil, err
+	}
+
+	limit, ok := limits[service]
+	if !ok {
+		if limit, err = concurrency(); err != nil {
+			return nil, err
+		}
+	}
+
+	l := newLimiter(limit)
+	limiters[service] = l
+
+	return l, nil
+}
+
+// concurrency returns the maximum number of resources deleted concurrently by a SweepOrchestrator.
+func concurrency() (int, error) {
+	v := os.Getenv(envvar.SweepConcurrency)
+	if v == "" {
+		return defaultConcurrency, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid %s value (%s): must be a positive integer", envvar.SweepConcurrency, v)
+	}
+
+	return n, nil
+}
+
+// serviceConcurrency returns the configured per-service concurrency limits.
+func serviceConcurrency() (map[string]int, error) {
+	limits := make(map[string]int)
+
+	v := os.Getenv(envvar.SweepServiceConcurrency)
+	if v == "" {
+		return limits, nil
+	}
+
+	for _, pair := range strings.Split(v, ",") {
+		service, limit, _ := strings.Cut(pair, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(limit))
+		if service = strings.TrimSpace(service); service == "" || err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid %s value (%s): must be comma-separated service=limit pairs", envvar.SweepServiceConcurrency, v)
+		}
+		limits[service] = n
+	}
+
+	return limits, nil
+}
+
+// addThrottleObserverSDKv1 reports throttled AWS SDK for Go v1 API call attempts to the limiter in the request's Context.
+func addThrottleObserverSDKv1(handlers *request_sdkv1.Handlers) {
+	handlers.AfterRetry.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: throttleObserverID,
+		Fn: func(r *request_sdkv1.Request) {
+			if l, ok := limiterFromContext(r.Context()); ok {
+				if r.Error != nil && request_sdkv1.IsErrorThrottle(r.Error) {
+					l.throttled()
+				}
+			}
+		},
+	})
+}
+
+// addThrottleObserverSDKv2 reports throttled AWS SDK for Go v2 API call attempts to the limiter in the operation's Context.
+func addThrottleObserverSDKv2(stack *middleware.Stack) error {
+	m := middleware.FinalizeMiddlewareFunc(
+		throttleObserverID,
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleFinalize(ctx, in)
+
+			if l, ok := limiterFromContext(ctx); ok {
+				if err != nil && retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary {
+					l.throttled()
+				}
+			}
+
+			return out, metadata, err
+		},
+	)
+
+	// Observe each attempt. The Retry middleware is in the Finalize step.
+	id := (&retry_sdkv2.Attempt{}).ID()
+	if _, ok := stack.Finalize.Get(id); ok {
+		return stack.Finalize.Insert(m, id, middleware.After)
+	}
+
+	return stack.Finalize.Add(m, middleware.After)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	multierror "github.com/hashicorp/go-multierror"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// progressInterval is the interval between progress log messages.
+const progressInterval = 30 * time.Second
+
+// sweepConcurrently deletes the specified Sweepables using a bounded pool of workers.
+// Deletions are further limited by the shared, throttling-aware limiter for the sweeper's service.
+func sweepConcurrently(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	n, err := concurrency()
+	if err != nil {
+		return err
+	}
+
+	service := resourceService(ctx, resourceTypeFromContext(ctx))
+	l, err := serviceLimiter(service)
+	if err != nil {
+		return err
+	}
+	ctx = withLimiter(ctx, l)
+
+	total := len(sweepables)
+	var completed, failed atomic.Int64
+	logProgress := func(msg string) {
+		tflog.Info(ctx, msg, map[string]any{
+			"service":     service,
+			"total":       total,
+			"completed":   completed.Load(),
+			"failed":      failed.Load(),
+			"concurrency": l.currentLimit(),
+		})
+	}
+
+	var (
+		errs *multierror.Error
+		lock sync.Mutex
+		wg   sync.WaitGroup
+	)
+	work := make(chan Sweepable)
+
+	for i := 0; i < min(n, total); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for sweepable := range work {
+				if err := sweepOne(ctx, l, sweepable, optFns...); err != nil {
+					failed.Add(1)
+					lock.Lock()
+					errs = multierror.Append(errs, err)
+					lock.Unlock()
+				}
+				completed.Add(1)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				logProgress("Sweeping resources")
+			}
+		}
+	}()
+
+	for _, sweepable := range sweepables {
+		work <- sweepable
+	}
+	close(work)
+
+	wg.Wait()
+	close(done)
+
+	if total > 0 {
+		logProgress("Swept resources")
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sweepOne deletes a single Sweepable once permitted by the limiter.
+func sweepOne(ctx context.Context, l *limiter, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if err := l.acquire(ctx); err != nil {
+		return err
+	}
+
+	err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+
+	if err != nil && isThrottlingError(err) {
+		l.throttled()
+	}
+	l.release(err == nil)
+
+	return err
+}
+
+// isThrottlingError returns whether or not the error is caused by AWS API throttling.
+// Sweepable deletion errors are typically converted from diagnostics, so a string comparison is used.
+func isThrottlingError(err error) bool {
+	// The throttling error codes defined by the AWS SDK for Go v2 are a superset of the
+	// codes defined by v1, so use the v2 codes here.
+	for _, code := range maps.Keys(awsretry.DefaultThrottleErrorCodes) {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	resourceServicesOnce sync.Once
+	resourceServices     map[string]string
+)
+
+// resourceService returns the name of the service package that registers the specified resource type.
+// If the resource type is not registered by any service package, the resource type itself is returned.
+func resourceService(ctx context.Context, resourceType string) string {
+	resourceServicesOnce.Do(func() {
+		resourceServices = make(map[string]string)
+
+		for _, sp := range ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				resourceServices[v.TypeName] = sp.ServicePackageName()
+			}
+			for _, v := range sp.FrameworkResources(ctx) {
+				resource, err := v.Factory(ctx)
+				if err != nil {
+					continue
+				}
+				var response fwresource.MetadataResponse
+				resource.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+				resourceServices[response.TypeName] = sp.ServicePackageName()
+			}
+		}
+	})
+
+	if v, ok := resourceServices[resourceType]; ok {
+		return v
+	}
+
+	return resourceType
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type concurrencyTracker struct {
+	current atomic.Int64
+	lock    sync.Mutex
+	max     int64
+}
+
+func (t *concurrencyTracker) enter() {
+	n := t.current.Add(1)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.max = max(t.max, n)
+}
+
+func (t *concurrencyTracker) exit() {
+	t.current.Add(-1)
+}
+
+type countingSweepable struct {
+	tracker *concurrencyTracker
+	err     error
+	deleted atomic.Bool
+}
+
+func (s *countingSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	s.tracker.enter()
+	defer s.tracker.exit()
+
+	time.Sleep(5 * time.Millisecond)
+	s.deleted.Store(true)
+
+	return s.err
+}
+
+func TestSweepOrchestratorConcurrency(t *testing.T) {
+	t.Setenv(envvar.SweepConcurrency, "3")
+
+	tracker := &concurrencyTracker{}
+	var sweepables []Sweepable
+	var all []*countingSweepable
+	for i := 0; i < 20; i++ {
+		v := &countingSweepable{tracker: tracker}
+		if i%5 == 0 {
+			v.err = errors.New("test")
+		}
+		all = append(all, v)
+		sweepables = append(sweepables, v)
+	}
+
+	ctx := withResourceType(Context("us-west-2"), "aws_test_concurrency") //lintignore:AWSAT003
+	err := SweepOrchestrator(ctx, sweepables)
+
+	var errs interface{ WrappedErrors() []error }
+	if !errors.As(err, &errs) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := len(errs.WrappedErrors()), 4; got != want {
+		t.Errorf("errors = %d, want %d", got, want)
+	}
+
+	for i, v := range all {
+		if !v.deleted.Load() {
+			t.Errorf("resource %d not deleted", i)
+		}
+	}
+
+	if got, want := tracker.max, int64(3); got > want {
+		t.Errorf("max concurrency = %d, want <= %d", got, want)
+	}
+}
+
+func TestSweepOrchestratorThrottling(t *testing.T) {
+	t.Setenv(envvar.SweepServiceConcurrency, "aws_test_throttling=8")
+
+	tracker := &concurrencyTracker{}
+	sweepables := []Sweepable{
+		&countingSweepable{tracker: tracker, err: errors.New("deleting: ThrottlingException: Rate exceeded")},
+	}
+
+	ctx := withResourceType(Context("us-west-2"), "aws_test_throttling") //lintignore:AWSAT003
+	if err := SweepOrchestrator(ctx, sweepables); err == nil {
+		t.Fatal("expected error")
+	}
+
+	l, err := serviceLimiter("aws_test_throttling")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := l.currentLimit(), 4; got != want {
+		t.Errorf("limit = %d, want %d", got, want)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newLimiter(2)
+
+	if err := l.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire over limit: %v", err)
+	}
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- l.acquire(ctx)
+	}()
+	l.release(true)
+	if err := <-acquired; err != nil {
+		t.Fatal(err)
+	}
+
+	l.throttled()
+	if got, want := l.currentLimit(), 1; got != want {
+		t.Errorf("limit after throttling = %d, want %d", got, want)
+	}
+	if backoff := time.Until(l.backoffUntil); backoff <= 0 || backoff > minBackoff {
+		t.Errorf("backoff = %s", backoff)
+	}
+
+	// Throttling during backoff is ignored.
+	l.throttled()
+	if got, want := l.backoff, minBackoff; got != want {
+		t.Errorf("backoff after repeated throttling = %s, want %s", got, want)
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	l.release(true)
+	l.release(true)
+	if err := l.acquire(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire during backoff: %v", err)
+	}
+
+	// The limit recovers after a limit's worth of successes.
+	if got, want := l.currentLimit(), 2; got != want {
+		t.Errorf("limit after successes = %d, want %d", got, want)
+	}
+}
+
+func TestServiceConcurrency(t *testing.T) {
+	testCases := map[string]struct {
+		value     string
+		want      map[string]int
+		wantError bool
+	}{
+		"empty": {
+			want: map[string]int{},
+		},
+		"valid": {
+			value: "ec2=5, iam=2",
+			want: map[string]int{
+				"ec2": 5,
+				"iam": 2,
+			},
+		},
+		"missing limit": {
+			value:     "ec2",
+			wantError: true,
+		},
+		"zero limit": {
+			value:     "ec2=0",
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envvar.SweepServiceConcurrency, testCase.value)
+
+			got, err := serviceConcurrency()
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("serviceConcurrency() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Skip returns whether or not the resource is excluded from sweeping by the configured filter.
+// If a filter is configured the resource is first read to obtain its current name, tags and creation time.
+func (sr *sweepResource) Skip(ctx context.Context) (bool, error) {
+	f, err := filter.FromEnv()
+
+	if err != nil {
+		return false, err
+	}
+
+	if f == nil {
+		return false, nil
+	}
+
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	// Transparently tagged resources set their tags in Context.
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return false, err
+	}
+
+	if sr.d.Id() == "" {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": "not found",
+		})
+		return true, nil
+	}
+
+	attributes := make(map[string]string)
+	if state := sr.d.State(); state != nil {
+		for k, v := range state.Attributes {
+			attributes[k] = v
+		}
+	}
+
+	if tags, err := sr.listTags(ctx); err != nil {
+		return false, err
+	} else if tags != nil {
+		filter.SetTags(attributes, tags)
+	}
+
+	if ok, reason := f.Match(attributes, time.Now()); !ok {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
+		})
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// listTags returns the tags of a transparently tagged resource.
+// Tags set in Context by the resource's Read handler are used if present, otherwise the service package's ListTags is called.
+// nil is returned if the resource is not transparently tagged.
+func (sr *sweepResource) listTags(ctx context.Context) (map[string]string, error) {
+	inContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	if inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.MustUnwrap().Map(), nil
+	}
+
+	registration, ok := lookupRegistration(ctx, sr.meta)
+	if !ok || registration.resource.Tags == nil {
+		return nil, nil
+	}
+
+	spt := registration.resource.Tags
+	var identifier string
+	if identifierAttribute := spt.IdentifierAttribute; identifierAttribute == "id" {
+		identifier = sr.d.Id()
+	} else if v, ok := sr.d.Get(identifierAttribute).(string); ok {
+		identifier = v
+	}
+
+	if identifier == "" {
+		return nil, nil
+	}
+
+	var err error
+	if v, ok := registration.servicePackage.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = v.ListTags(ctx, sr.meta, identifier) // Sets tags in Context
+	} else if v, ok := registration.servicePackage.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && spt.ResourceType != "" {
+		err = v.ListTags(ctx, sr.meta, identifier, spt.ResourceType) // Sets tags in Context
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.MustUnwrap().Map(), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestSweepResourceListTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName string
+		expected map[string]string
+	}{
+		"policy": {
+			typeName: "aws_test_policy",
+			expected: map[string]string{"Name": "policy"},
+		},
+		"queue": {
+			typeName: "aws_test_queue",
+			expected: map[string]string{"Name": "queue"},
+		},
+		"unknown": {
+			typeName: "aws_test_unknown",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "", "", testCase.typeName)
+			ctx = tftags.NewContext(ctx, nil, nil)
+
+			resource := testResource()
+			d := resource.Data(nil)
+			d.SetId("example")
+			if err := d.Set("arn", "arn:aws:test:::example"); err != nil { //lintignore:AWSAT005
+				t.Fatal(err)
+			}
+
+			sr := newSweepResource(resource, d, testMeta)
+			got, err := sr.listTags(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -40,6 +40,12 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if skip, err := sr.Skip(ctx); err != nil {
+		return err
+	} else if skip {
+		return nil
+	}
+
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
@@ -84,6 +90,24 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the resource's Terraform type name and its identifying attributes.
+// Only top-level primitive attributes are returned.
+func (sr *sweepResource) Describe(ctx context.Context) (string, map[string]string, error) {
+	attributes := make(map[string]string)
+
+	if state := sr.d.State(); state != nil {
+		for k, v := range state.Attributes {
+			if strings.Contains(k, ".") {
+				continue
+			}
+			attributes[k] = v
+		}
+	}
+	attributes["id"] = sr.d.Id()
+
+	return resourceTypeName(ctx), attributes, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
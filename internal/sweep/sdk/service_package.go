@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// resourceRegistration is a resource's registration with its service package.
+type resourceRegistration struct {
+	servicePackage conns.ServicePackage
+	resource       *types.ServicePackageSDKResource
+}
+
+var (
+	registrationsOnce sync.Once
+	registrations     map[string]resourceRegistration
+)
+
+// lookupRegistration returns the service package registration of the sweeper's resource type, if known.
+// Sweepers construct resources from their factory functions rather than by type name,
+// so the resource type registered with the sweeper is used.
+func lookupRegistration(ctx context.Context, meta *conns.AWSClient) (resourceRegistration, bool) {
+	if meta == nil {
+		return resourceRegistration{}, false
+	}
+
+	registrationsOnce.Do(func() {
+		registrations = make(map[string]resourceRegistration)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				registrations[v.TypeName] = resourceRegistration{
+					servicePackage: sp,
+					resource:       v,
+				}
+			}
+		}
+	})
+
+	v, ok := registrations[resourceTypeName(ctx)]
+
+	return v, ok
+}
+
+// resourceTypeName returns the Terraform type name of the sweeper's resource type, or "" if it is not known.
+func resourceTypeName(ctx context.Context) string {
+	if v, ok := conns.FromContext(ctx); ok {
+		return v.TypeName
+	}
+
+	return ""
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+)
+
+type testHandler struct{}
+
+func (testHandler) Delete(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return nil
+}
+
+// testResource returns a resource whose Delete handler is the same method value for every resource.
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		DeleteWithoutTimeout: testHandler{}.Delete,
+	}
+}
+
+// testServicePackage is a service package with a single transparently tagged resource.
+type testServicePackage struct {
+	conns.ServicePackage
+
+	typeName            string
+	identifierAttribute string
+	tags                map[string]string
+}
+
+func (sp testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  testResource,
+			TypeName: sp.typeName,
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: sp.identifierAttribute,
+			},
+		},
+	}
+}
+
+func (sp testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, sp.tags))
+	}
+
+	return nil
+}
+
+// testMeta is shared by all tests as service package registrations are cached.
+var testMeta = &conns.AWSClient{
+	ServicePackages: map[string]conns.ServicePackage{
+		"testiam": testServicePackage{
+			typeName:            "aws_test_policy",
+			identifierAttribute: "arn",
+			tags:                map[string]string{"Name": "policy"},
+		},
+		"testsqs": testServicePackage{
+			typeName:            "aws_test_queue",
+			identifierAttribute: "id",
+			tags:                map[string]string{"Name": "queue"},
+		},
+	},
+}
+
+func TestLookupRegistration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName           string
+		expectedOK         bool
+		expectedIdentifier string
+	}{
+		"policy": {
+			typeName:           "aws_test_policy",
+			expectedOK:         true,
+			expectedIdentifier: "arn",
+		},
+		"queue": {
+			typeName:           "aws_test_queue",
+			expectedOK:         true,
+			expectedIdentifier: "id",
+		},
+		"unknown": {
+			typeName: "aws_test_unknown",
+		},
+		"no type": {},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.typeName != "" {
+				ctx = conns.NewResourceContext(ctx, "", "", testCase.typeName)
+			}
+
+			got, ok := lookupRegistration(ctx, testMeta)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("lookupRegistration ok = %t, want %t", ok, testCase.expectedOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got, want := got.resource.TypeName, testCase.typeName; got != want {
+				t.Errorf("TypeName = %s, want %s", got, want)
+			}
+			if got, want := got.resource.Tags.IdentifierAttribute, testCase.expectedIdentifier; got != want {
+				t.Errorf("IdentifierAttribute = %s, want %s", got, want)
+			}
+		})
+	}
+}
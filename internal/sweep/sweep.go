@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -87,6 +86,14 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		}
 	}
 
+	// Throttled API calls reduce the concurrency of sweeping.
+	conf.APIOptions = append(conf.APIOptions, addThrottleObserverSDKv2)
+
+	// In dry-run mode only read-only AWS API calls are permitted.
+	if DryRun() {
+		conf.APIOptions = append(conf.APIOptions, addReadOnlyGuardSDKv2)
+	}
+
 	// configures a default client for the region, using the above env vars
 	client, diags := conf.ConfigureProvider(ctx, meta)
 
@@ -94,6 +101,12 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
+	addThrottleObserverSDKv1(&client.Session.Handlers)
+
+	if DryRun() {
+		addReadOnlyGuardSDKv1(&client.Session.Handlers)
+	}
+
 	sweeperClients[region] = client
 
 	return client, nil
@@ -108,17 +121,11 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
-	var g multierror.Group
-
-	for _, sweepable := range sweepables {
-		sweepable := sweepable
-
-		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
-		})
+	if DryRun() {
+		return recordInventory(ctx, sweepables)
 	}
 
-	return g.Wait().ErrorOrNil()
+	return sweepConcurrently(ctx, sweepables, optFns...)
 }
 
 // Deprecated: Usse awsv1.SkipSweepError
@@ -147,6 +154,7 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 		Name: name,
 		F: func(region string) error {
 			ctx := Context(region)
+			ctx = withResourceType(ctx, name)
 			ctx = logWithResourceType(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
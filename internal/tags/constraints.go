@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	// AllowedCharactersDefault is the set of characters allowed in tag keys and values by most AWS services.
+	AllowedCharactersDefault = `\p{L}\p{Z}\p{N}_.:/=+\-@`
+	// ReservedKeyPrefixDefault is the tag key prefix reserved for use by AWS.
+	ReservedKeyPrefixDefault = "aws:"
+)
+
+// Constraints are a service's limits on the tags of a single resource.
+// Zero-valued fields are not enforced.
+type Constraints struct {
+	// MaxCount is the maximum number of tags.
+	MaxCount int
+	// MaxKeyLength is the maximum length of a tag key in Unicode characters.
+	MaxKeyLength int
+	// MaxValueLength is the maximum length of a tag value in Unicode characters.
+	MaxValueLength int
+	// AllowedCharacters is a regular expression character class, without the enclosing brackets,
+	// matching the characters allowed in tag keys and values, e.g. AllowedCharactersDefault.
+	AllowedCharacters string
+	// KeysCaseInsensitive is true if tag keys that differ only in case are the same key.
+	KeysCaseInsensitive bool
+	// ReservedKeyPrefix is a prefix, compared case-insensitively, that tag keys may not start with, e.g. ReservedKeyPrefixDefault.
+	ReservedKeyPrefix string
+	// ResourceTypes are the constraints of specific resource types, e.g. "aws_s3_object",
+	// that differ from the service's. They replace rather than add to the service's constraints.
+	ResourceTypes map[string]*Constraints
+}
+
+// ForResourceType returns the constraints on the tags of a resource of the specified type.
+func (c *Constraints) ForResourceType(resourceType string) *Constraints {
+	if c == nil {
+		return nil
+	}
+
+	if v, ok := c.ResourceTypes[resourceType]; ok {
+		return v
+	}
+
+	return c
+}
+
+// Validate returns an error if the specified tags do not satisfy the constraints.
+func (c *Constraints) Validate(tags KeyValueTags) error {
+	if c == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if c.MaxCount > 0 && len(tags) > c.MaxCount {
+		errs = append(errs, fmt.Errorf("too many tags (%d), maximum is %d", len(tags), c.MaxCount))
+	}
+
+	var allowed func(string) bool
+	if c.AllowedCharacters != "" {
+		allowed = regexache.MustCompile(fmt.Sprintf(`^[%s]*$`, c.AllowedCharacters)).MatchString
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+	folded := make(map[string]string, len(keys))
+
+	for _, key := range keys {
+		var value string
+		if v := tags.KeyValue(key); v != nil {
+			value = *v
+		}
+
+		if c.MaxKeyLength > 0 && utf8.RuneCountInString(key) > c.MaxKeyLength {
+			errs = append(errs, fmt.Errorf("tag key (%s) exceeds maximum length (%d)", key, c.MaxKeyLength))
+		}
+
+		if c.MaxValueLength > 0 && utf8.RuneCountInString(value) > c.MaxValueLength {
+			errs = append(errs, fmt.Errorf("tag (%s) value exceeds maximum length (%d)", key, c.MaxValueLength))
+		}
+
+		if prefix := c.ReservedKeyPrefix; prefix != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			errs = append(errs, fmt.Errorf("tag key (%s) must not start with (%s)", key, prefix))
+		}
+
+		if allowed != nil {
+			if !allowed(key) {
+				errs = append(errs, fmt.Errorf("tag key (%s) contains characters that are not allowed", key))
+			}
+
+			if !allowed(value) {
+				errs = append(errs, fmt.Errorf("tag (%s) value (%s) contains characters that are not allowed", key, value))
+			}
+		}
+
+		if c.KeysCaseInsensitive {
+			k := strings.ToLower(key)
+
+			if other, ok := folded[k]; ok {
+				errs = append(errs, fmt.Errorf("tag keys (%s) and (%s) differ only in case", other, key))
+			} else {
+				folded[k] = key
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
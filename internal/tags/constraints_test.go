@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestConstraintsValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	constraints := &Constraints{
+		MaxCount:            3,
+		MaxKeyLength:        8,
+		MaxValueLength:      10,
+		AllowedCharacters:   AllowedCharactersDefault,
+		KeysCaseInsensitive: true,
+		ReservedKeyPrefix:   ReservedKeyPrefixDefault,
+	}
+
+	testCases := []struct {
+		name        string
+		constraints *Constraints
+		tags        map[string]string
+		wantErr     string
+	}{
+		{
+			name: "nil constraints",
+			tags: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:        "satisfied",
+			constraints: constraints,
+			tags: map[string]string{
+				"Name":    "tést value",
+				"cost:id": "1234",
+				"team":    "",
+			},
+		},
+		{
+			name:        "too many tags",
+			constraints: constraints,
+			tags: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			},
+			wantErr: "too many tags (4), maximum is 3",
+		},
+		{
+			name:        "key too long",
+			constraints: constraints,
+			tags: map[string]string{
+				"key123456": "value1",
+			},
+			wantErr: "tag key (key123456) exceeds maximum length (8)",
+		},
+		{
+			name:        "value too long",
+			constraints: constraints,
+			tags: map[string]string{
+				"key1": strings.Repeat("v", 11),
+			},
+			wantErr: "tag (key1) value exceeds maximum length (10)",
+		},
+		{
+			name:        "disallowed characters",
+			constraints: constraints,
+			tags: map[string]string{
+				"key#1": "value1",
+				"key2":  "value*2",
+			},
+			wantErr: "tag key (key#1) contains characters that are not allowed\ntag (key2) value (value*2) contains characters that are not allowed",
+		},
+		{
+			name:        "keys differ only in case",
+			constraints: constraints,
+			tags: map[string]string{
+				"Name": "value1",
+				"name": "value2",
+			},
+			wantErr: "tag keys (Name) and (name) differ only in case",
+		},
+		{
+			name:        "reserved prefix",
+			constraints: constraints,
+			tags: map[string]string{
+				"AWS:key": "value1",
+				"awskey":  "value2",
+			},
+			wantErr: "tag key (AWS:key) must not start with (aws:)",
+		},
+		{
+			name:        "keys differ only in case sensitive",
+			constraints: &Constraints{},
+			tags: map[string]string{
+				"Name": "value1",
+				"name": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.constraints.Validate(New(ctx, testCase.tags))
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() err = %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Validate() expected error: %s", testCase.wantErr)
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("Validate() err = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestConstraintsValidate_maxCountZero(t *testing.T) {
+	t.Parallel()
+
+	tags := make(map[string]string)
+	for i := 0; i < 100; i++ {
+		tags[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	if err := (&Constraints{}).Validate(New(context.Background(), tags)); err != nil {
+		t.Errorf("Validate() err = %s", err)
+	}
+}
+
+func TestConstraintsForResourceType(t *testing.T) {
+	t.Parallel()
+
+	object := &Constraints{MaxCount: 10}
+	constraints := &Constraints{
+		MaxCount: 50,
+		ResourceTypes: map[string]*Constraints{
+			"aws_s3_object": object,
+		},
+	}
+
+	if got, want := constraints.ForResourceType("aws_s3_object"), object; got != want {
+		t.Errorf("ForResourceType(aws_s3_object) = %v, want %v", got, want)
+	}
+	if got, want := constraints.ForResourceType("aws_s3_bucket"), constraints; got != want {
+		t.Errorf("ForResourceType(aws_s3_bucket) = %v, want %v", got, want)
+	}
+	if got := (*Constraints)(nil).ForResourceType("aws_s3_bucket"); got != nil {
+		t.Errorf("ForResourceType(aws_s3_bucket) = %v, want nil", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,14 +32,58 @@ const (
 )
 
 // DefaultConfig contains tags to default across all resources.
+// If the configuration selects resource types, use ForResourceType to obtain the configuration for a specific resource type.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ResourceTypes selects the resource types Tags are defaulted on.
+	ResourceTypes ResourceTypeSelector
+	// ScopedTags are additional tags defaulted on selected resource types.
+	ScopedTags []ScopedDefaultTags
+}
+
+// ScopedDefaultTags contains tags to default across selected resource types.
+type ScopedDefaultTags struct {
+	Tags          KeyValueTags
+	ResourceTypes ResourceTypeSelector
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	Tags        []IgnoreTag
+}
+
+// IgnoreTag matches tags by both key and value.
+type IgnoreTag struct {
+	KeyRegex   *regexp.Regexp
+	ValueRegex *regexp.Regexp
+}
+
+// NewTagRegexp returns a regular expression that must match the whole of a tag key or value.
+func NewTagRegexp(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// NewIgnoreTag returns an IgnoreTag matching tags whose key and value match the specified regular expressions.
+func NewIgnoreTag(keyPattern, valuePattern string) (IgnoreTag, error) {
+	keyRegex, err := NewTagRegexp(keyPattern)
+
+	if err != nil {
+		return IgnoreTag{}, fmt.Errorf("key (%s): %w", keyPattern, err)
+	}
+
+	valueRegex, err := NewTagRegexp(valuePattern)
+
+	if err != nil {
+		return IgnoreTag{}, fmt.Errorf("value (%s): %w", valuePattern, err)
+	}
+
+	return IgnoreTag{
+		KeyRegex:   keyRegex,
+		ValueRegex: valueRegex,
+	}, nil
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -47,31 +92,59 @@ type IgnoreConfig struct {
 // across all these Go types, we convert them into this Go type.
 type KeyValueTags map[string]*TagData
 
+// ForResourceType returns the configuration for the specified resource type,
+// containing only the tags defaulted on that resource type.
+// An empty resource type selects only those tags not restricted to included resource types.
+func (dc *DefaultConfig) ForResourceType(resourceType string) *DefaultConfig {
+	if dc == nil || (dc.ResourceTypes.IsEmpty() && len(dc.ScopedTags) == 0) {
+		return dc
+	}
+
+	result := &DefaultConfig{}
+
+	if dc.ResourceTypes.Selects(resourceType) {
+		result.Tags = dc.Tags
+	}
+
+	for _, v := range dc.ScopedTags {
+		if v.ResourceTypes.Selects(resourceType) {
+			result.Tags = result.Tags.Merge(v.Tags)
+		}
+	}
+
+	return result
+}
+
 // GetTags is convenience method that returns the DefaultConfig's Tags, if any
 func (dc *DefaultConfig) GetTags() KeyValueTags {
 	if dc == nil {
 		return nil
 	}
 
-	return dc.Tags
+	return dc.ForResourceType("").Tags
 }
 
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// Any resource type selectors are honored as for ForResourceType.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+	defaultTags := dc.GetTags()
+
+	if defaultTags == nil {
 		return tags
 	}
 
-	return dc.Tags.Merge(tags)
+	return defaultTags.Merge(tags)
 }
 
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
 func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
-	if dc == nil || dc.Tags == nil {
+	defaultTags := dc.GetTags()
+
+	if defaultTags == nil {
 		return tags == nil
 	}
 
@@ -80,10 +153,10 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	}
 
 	if len(tags) == 0 {
-		return len(dc.Tags) == 0
+		return len(defaultTags) == 0
 	}
 
-	return dc.Tags.ContainsAll(tags)
+	return defaultTags.ContainsAll(tags)
 }
 
 // IgnoreAWS returns non-AWS tag keys.
@@ -107,6 +180,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreTags(config.Tags)
 
 	return result
 }
@@ -158,6 +233,59 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns non-matching tag keys.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreTags returns tags not matching both the key and value of any IgnoreTag.
+func (tags KeyValueTags) IgnoreTags(ignoreTags []IgnoreTag) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		var value string
+		if v != nil {
+			value = v.ValueString()
+		}
+
+		for _, ignoreTag := range ignoreTags {
+			if ignoreTag.KeyRegex.MatchString(k) && ignoreTag.ValueRegex.MatchString(value) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -504,14 +632,16 @@ func (tags KeyValueTags) Hash() int {
 // in the given KeyValueTags, then the KeyValueTags are returned, effectively
 // bypassing the need to remove differing tags.
 func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+	defaultTags := dc.GetTags()
+
+	if defaultTags == nil {
 		return tags
 	}
 
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		if defaultVal, ok := defaultTags[k]; !ok || !v.Equal(defaultVal) {
 			result[k] = v
 		}
 	}
@@ -812,7 +942,7 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == v.value {
+				if val, ok := defaultConfig.GetTags()[k]; ok && val.ValueString() == v.value {
 					// config does not exist during a refresh.
 					// set duplicate values from other sources for refresh diff calculation
 					if !configExists {
@@ -859,7 +989,7 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 					)
 				}
 
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == s {
+				if val, ok := defaultConfig.GetTags()[k]; ok && val.ValueString() == s {
 					result[k] = s
 				}
 			}
@@ -5,8 +5,10 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -317,6 +319,128 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Environment": "prod",
+			"Team":        "platform",
+		}),
+		ResourceTypes: ResourceTypeSelector{
+			Exclude: []string{"aws_autoscaling_group"},
+		},
+		ScopedTags: []ScopedDefaultTags{
+			{
+				Tags: New(ctx, map[string]string{
+					"DataClassification": "confidential",
+				}),
+				ResourceTypes: ResourceTypeSelector{
+					Include: []string{"aws_s3_*", "aws_db_instance"},
+				},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Team": "data",
+				}),
+				ResourceTypes: ResourceTypeSelector{
+					Include: []string{"aws_db_instance"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		want          map[string]string
+	}{
+		{
+			name:         "nil config",
+			resourceType: "aws_instance",
+		},
+		{
+			name: "no selectors",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Environment": "prod",
+				}),
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"Environment": "prod",
+			},
+		},
+		{
+			name:          "unscoped",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_instance",
+			want: map[string]string{
+				"Environment": "prod",
+				"Team":        "platform",
+			},
+		},
+		{
+			name:          "scoped",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_s3_bucket",
+			want: map[string]string{
+				"DataClassification": "confidential",
+				"Environment":        "prod",
+				"Team":               "platform",
+			},
+		},
+		{
+			name:          "scoped override",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_db_instance",
+			want: map[string]string{
+				"DataClassification": "confidential",
+				"Environment":        "prod",
+				"Team":               "data",
+			},
+		},
+		{
+			name:          "excluded",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_autoscaling_group",
+			want:          map[string]string{},
+		},
+		{
+			name:          "no resource type",
+			defaultConfig: defaultConfig,
+			want: map[string]string{
+				"Environment": "prod",
+				"Team":        "platform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.resourceType)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Errorf("ForResourceType() = %v, want nil", got)
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+
+			if merged := got.MergeTags(New(ctx, map[string]string{"Name": "test"})).Map(); len(merged) != len(testCase.want)+1 {
+				t.Errorf("MergeTags() = %v", merged)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -568,6 +692,69 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"kubernetes.io/role/elb":     "1",
+				"key3":                       "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^kubernetes\.io/cluster/.+$`),
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/role/elb": "1",
+				"key3":                   "value3",
+			},
+		},
+		{
+			name: "tags some matching",
+			tags: New(ctx, map[string]string{
+				"backup:last":   "20240301T120000Z",
+				"backup:policy": "daily",
+				"key3":          "20240301T120000Z",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Tags: []IgnoreTag{
+					{
+						KeyRegex:   regexache.MustCompile(`^backup:.*$`),
+						ValueRegex: regexache.MustCompile(`^[0-9]{8}T[0-9]{6}Z$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"backup:policy": "daily",
+				"key3":          "20240301T120000Z",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(ctx, map[string]string{
+				"key1":       "value1",
+				"prefix:key": "value2",
+				"regex-key3": "value3",
+				"key4":       "ignored",
+				"key5":       "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key1"}),
+				KeyPrefixes: New(ctx, []string{"prefix:"}),
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^regex-.*$`),
+				},
+				Tags: []IgnoreTag{
+					{
+						KeyRegex:   regexache.MustCompile(`^key[0-9]$`),
+						ValueRegex: regexache.MustCompile(`^ignored$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key5": "value5",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -582,6 +769,27 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestNewIgnoreTag(t *testing.T) {
+	t.Parallel()
+
+	ignoreTag, err := NewIgnoreTag(`backup:.*`, `[0-9]{8}`)
+	if err != nil {
+		t.Fatalf("NewIgnoreTag() err = %s", err)
+	}
+
+	if !ignoreTag.KeyRegex.MatchString("backup:last") || !ignoreTag.ValueRegex.MatchString("20240301") {
+		t.Error("expected key and value to match")
+	}
+
+	if ignoreTag.KeyRegex.MatchString("xbackup:last") || ignoreTag.ValueRegex.MatchString("202403011") {
+		t.Error("expected patterns to match whole key and value")
+	}
+
+	if _, err := NewIgnoreTag(`backup:.*`, `[0-9`); err == nil {
+		t.Error("NewIgnoreTag() expected error")
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// RequiredConfig contains tags that must be present on taggable resources.
+type RequiredConfig struct {
+	Tags []RequiredTag
+}
+
+// RequiredTag is a tag that must be present on taggable resources.
+type RequiredTag struct {
+	Key string
+	// AllowedValues are regular expressions, one of which must match the tag value.
+	// If empty, any value is allowed.
+	AllowedValues []*regexp.Regexp
+	// ResourceTypes are the resource types, e.g. "aws_instance", the tag is required on.
+	// A trailing "*" matches any suffix. If empty, the tag is required on all taggable resources.
+	ResourceTypes []string
+}
+
+// NewRequiredTag returns a RequiredTag for the specified key.
+// Each allowed value is a regular expression that must match the whole tag value.
+func NewRequiredTag(key string, allowedValues, resourceTypes []string) (RequiredTag, error) {
+	requiredTag := RequiredTag{
+		Key:           key,
+		ResourceTypes: resourceTypes,
+	}
+
+	for _, v := range allowedValues {
+		re, err := NewTagRegexp(v)
+
+		if err != nil {
+			return RequiredTag{}, fmt.Errorf("tag (%s) allowed value (%s): %w", key, v, err)
+		}
+
+		requiredTag.AllowedValues = append(requiredTag.AllowedValues, re)
+	}
+
+	return requiredTag, nil
+}
+
+// Validate returns an error if the specified resource type's tags, including any default tags,
+// are missing a required tag or have a required tag with a value that is not allowed.
+func (rc *RequiredConfig) Validate(resourceType string, tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, requiredTag := range rc.Tags {
+		if !requiredTag.appliesTo(resourceType) {
+			continue
+		}
+
+		if !tags.KeyExists(requiredTag.Key) {
+			errs = append(errs, fmt.Errorf("missing required tag (%s)", requiredTag.Key))
+			continue
+		}
+
+		var value string
+		if v := tags.KeyValue(requiredTag.Key); v != nil {
+			value = *v
+		}
+
+		if !requiredTag.allows(value) {
+			errs = append(errs, fmt.Errorf("tag (%s) value (%s) does not match any allowed value", requiredTag.Key, value))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (rt RequiredTag) appliesTo(resourceType string) bool {
+	return ResourceTypeSelector{Include: rt.ResourceTypes}.Selects(resourceType)
+}
+
+func (rt RequiredTag) allows(value string) bool {
+	if len(rt.AllowedValues) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(rt.AllowedValues, func(re *regexp.Regexp) bool {
+		return re.MatchString(value)
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewRequiredTag(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewRequiredTag("CostCenter", []string{`[0-9]{4}`}, nil); err != nil {
+		t.Errorf("NewRequiredTag() err = %s", err)
+	}
+
+	if _, err := NewRequiredTag("CostCenter", []string{`[0-9`}, nil); err == nil {
+		t.Error("NewRequiredTag() expected error")
+	}
+}
+
+func TestRequiredConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	costCenter, err := NewRequiredTag("CostCenter", []string{`[0-9]{4}`, `shared`}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataClassification, err := NewRequiredTag("DataClassification", nil, []string{"aws_s3_bucket", "aws_dynamodb_*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requiredConfig := &RequiredConfig{
+		Tags: []RequiredTag{costCenter, dataClassification},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		tags           map[string]string
+		wantErr        string
+	}{
+		{
+			name:         "nil config",
+			resourceType: "aws_instance",
+		},
+		{
+			name:           "satisfied",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: map[string]string{
+				"CostCenter": "1234",
+			},
+		},
+		{
+			name:           "missing",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: map[string]string{
+				"Name": "test",
+			},
+			wantErr: "missing required tag (CostCenter)",
+		},
+		{
+			name:           "value not allowed",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: map[string]string{
+				"CostCenter": "12345",
+			},
+			wantErr: "tag (CostCenter) value (12345) does not match any allowed value",
+		},
+		{
+			name:           "second value allowed",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: map[string]string{
+				"CostCenter": "shared",
+			},
+		},
+		{
+			name:           "scoped resource type",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_s3_bucket",
+			tags: map[string]string{
+				"CostCenter": "1234",
+			},
+			wantErr: "missing required tag (DataClassification)",
+		},
+		{
+			name:           "scoped resource type prefix",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_dynamodb_table",
+			wantErr:        "missing required tag (CostCenter)\nmissing required tag (DataClassification)",
+		},
+		{
+			name:           "scoped resource type satisfied",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_dynamodb_table",
+			tags: map[string]string{
+				"CostCenter":         "1234",
+				"DataClassification": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.requiredConfig.Validate(testCase.resourceType, New(ctx, testCase.tags))
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() err = %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Validate() expected error: %s", testCase.wantErr)
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("Validate() err = %q, want %q", got, want)
+			}
+		})
+	}
+}
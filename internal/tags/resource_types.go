@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"slices"
+	"strings"
+)
+
+// ResourceTypeSelector selects resource types, e.g. "aws_instance", by name.
+// A trailing "*" in a resource type matches any suffix, e.g. "aws_s3_*".
+type ResourceTypeSelector struct {
+	// Include is the resource types selected. If empty, all resource types not excluded are selected.
+	Include []string
+	// Exclude is the resource types not selected, even if included.
+	Exclude []string
+}
+
+// IsEmpty returns whether or not the selector selects all resource types.
+func (s ResourceTypeSelector) IsEmpty() bool {
+	return len(s.Include) == 0 && len(s.Exclude) == 0
+}
+
+// Selects returns whether or not the specified resource type is selected.
+func (s ResourceTypeSelector) Selects(resourceType string) bool {
+	if matchResourceType(s.Exclude, resourceType) {
+		return false
+	}
+
+	return len(s.Include) == 0 || matchResourceType(s.Include, resourceType)
+}
+
+// matchResourceType returns whether or not the resource type matches any of the specified resource types.
+func matchResourceType(resourceTypes []string, resourceType string) bool {
+	return slices.ContainsFunc(resourceTypes, func(v string) bool {
+		if prefix, ok := strings.CutSuffix(v, "*"); ok {
+			return strings.HasPrefix(resourceType, prefix)
+		}
+		return v == resourceType
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"testing"
+)
+
+func TestResourceTypeSelectorSelects(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		selector     ResourceTypeSelector
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "empty",
+			resourceType: "aws_instance",
+			want:         true,
+		},
+		{
+			name: "included",
+			selector: ResourceTypeSelector{
+				Include: []string{"aws_s3_bucket", "aws_db_instance"},
+			},
+			resourceType: "aws_db_instance",
+			want:         true,
+		},
+		{
+			name: "not included",
+			selector: ResourceTypeSelector{
+				Include: []string{"aws_s3_bucket", "aws_db_instance"},
+			},
+			resourceType: "aws_instance",
+		},
+		{
+			name: "included prefix",
+			selector: ResourceTypeSelector{
+				Include: []string{"aws_s3_*"},
+			},
+			resourceType: "aws_s3_bucket",
+			want:         true,
+		},
+		{
+			name: "excluded",
+			selector: ResourceTypeSelector{
+				Exclude: []string{"aws_autoscaling_group"},
+			},
+			resourceType: "aws_autoscaling_group",
+		},
+		{
+			name: "not excluded",
+			selector: ResourceTypeSelector{
+				Exclude: []string{"aws_autoscaling_group"},
+			},
+			resourceType: "aws_instance",
+			want:         true,
+		},
+		{
+			name: "included and excluded",
+			selector: ResourceTypeSelector{
+				Include: []string{"aws_s3_*"},
+				Exclude: []string{"aws_s3_object"},
+			},
+			resourceType: "aws_s3_object",
+		},
+		{
+			name: "no resource type",
+			selector: ResourceTypeSelector{
+				Include: []string{"*"},
+			},
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.selector.Selects(testCase.resourceType), testCase.want; got != want {
+				t.Errorf("Selects(%q) = %t, want %t", testCase.resourceType, got, want)
+			}
+		})
+	}
+}
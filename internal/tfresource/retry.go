@@ -307,7 +307,7 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 
 	options.Apply(c)
 
-	_, waitErr := c.WaitForStateContext(ctx)
+	_, waitErr := WaitForStateContext(ctx, c)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
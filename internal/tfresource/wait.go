@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 type WaitOpts struct {
@@ -53,7 +54,26 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := WaitForStateContext(ctx, stateConf)
 
 	return err
 }
+
+// vcrPollInterval is the poll interval used when replaying VCR cassettes.
+// It must be non-zero so that it overrides the exponential backoff.
+const vcrPollInterval = 1 * time.Millisecond
+
+// WaitForStateContext waits for the state described by `conf` to be reached.
+// When replaying VCR cassettes no time is spent waiting before or between refreshes.
+func WaitForStateContext(ctx context.Context, conf *retry.StateChangeConf) (interface{}, error) {
+	if vcr.IsReplaying(ctx) {
+		// Don't modify the caller's configuration.
+		c := *conf
+		c.Delay = 0
+		c.MinTimeout = 0
+		c.PollInterval = vcrPollInterval
+		conf = &c
+	}
+
+	return conf.WaitForStateContext(ctx)
+}
@@ -9,8 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 func TestWaitUntil(t *testing.T) { //nolint:tparallel
@@ -70,3 +72,40 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitForStateContextVCRReplaying(t *testing.T) {
+	t.Parallel()
+
+	ctx := vcr.NewReplayingContext(acctest.Context(t))
+
+	var refreshCount int32
+	conf := &retry.StateChangeConf{
+		Delay:        1 * time.Hour,
+		MinTimeout:   1 * time.Hour,
+		Pending:      []string{"pending"},
+		PollInterval: 1 * time.Hour,
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 3 {
+				return 42, "pending", nil
+			}
+
+			return 42, "done", nil
+		},
+		Target:  []string{"done"},
+		Timeout: 1 * time.Minute,
+	}
+
+	start := time.Now()
+	if _, err := tfresource.WaitForStateContext(ctx, conf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("waited %s, expected no waits", elapsed)
+	}
+
+	// The caller's configuration is unchanged.
+	if got, want := conf.PollInterval, 1*time.Hour; got != want {
+		t.Errorf("PollInterval = %s, want %s", got, want)
+	}
+}
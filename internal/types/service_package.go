@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,6 +26,13 @@ type ServicePackageFrameworkDataSource struct {
 	Tags    *ServicePackageResourceTags
 }
 
+// ServicePackageFrameworkFunction represents a Terraform Plugin Framework provider function
+// implemented by a service package.
+type ServicePackageFrameworkFunction struct {
+	Factory func() function.Function
+	Name    string
+}
+
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
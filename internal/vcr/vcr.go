@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package vcr reads the VCR (recording and replaying of AWS API interactions) configuration for acceptance tests
+// and carries the replay mode from the acceptance test framework to the provider's retry and wait helpers.
+// Provider code must only use the replay mode in Context; the environment is read by the acceptance test framework.
+package vcr
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	envVarMode = "VCR_MODE"
+	envVarPath = "VCR_PATH"
+)
+
+// IsEnabled returns whether or not VCR is enabled, i.e. both VCR_MODE and VCR_PATH are set.
+func IsEnabled() bool {
+	return os.Getenv(envVarMode) != "" && os.Getenv(envVarPath) != ""
+}
+
+// Mode returns the recorder mode set by VCR_MODE.
+func Mode() (recorder.Mode, error) {
+	switch v := os.Getenv(envVarMode); v {
+	case "RECORDING":
+		return recorder.ModeRecordOnce, nil
+	case "REPLAYING":
+		return recorder.ModeReplayOnly, nil
+	default:
+		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarMode, v)
+	}
+}
+
+// Path returns the directory containing VCR cassettes set by VCR_PATH.
+func Path() string {
+	return os.Getenv(envVarPath)
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewReplayingContext returns a Context indicating that AWS API responses are being replayed from a VCR cassette.
+func NewReplayingContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey, true)
+}
+
+// IsReplaying returns whether or not AWS API responses are being replayed from a VCR cassette.
+// When replaying there is no need to wait for resources to change state.
+func IsReplaying(ctx context.Context) bool {
+	v, ok := ctx.Value(contextKey).(bool)
+	return ok && v
+}
@@ -23,7 +23,7 @@ import (
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigForContext(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
@@ -43,6 +43,20 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Enforce any provider configured required_tags on the merged resource and provider-level tags.
+	var resourceType string
+	if inContext, ok := conns.FromContext(ctx); ok {
+		resourceType = inContext.TypeName
+	}
+	if err := meta.(*conns.AWSClient).RequiredTagsConfig.Validate(resourceType, defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+		return fmt.Errorf("tags_all does not satisfy the provider's required_tags:\n%w", err)
+	}
+
+	// Enforce any service tag constraints, e.g. maximum number of tags, on the merged tags.
+	if err := meta.(*conns.AWSClient).TagConstraintsForContext(ctx).Validate(allTags); err != nil {
+		return fmt.Errorf("tags_all does not satisfy the service's tag constraints:\n%w", err)
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
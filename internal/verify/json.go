@@ -15,6 +15,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
@@ -168,6 +169,34 @@ func PolicyToSet(exist, new string) (string, error) {
 	return policyToSet, nil
 }
 
+// CanonicalPolicyToSet returns the existing policy if the new policy is equivalent.
+// Otherwise, it returns the new policy. Either policy is put in canonical form, so that
+// the value in state doesn't depend on how AWS formats the policy document.
+func CanonicalPolicyToSet(exist, new string) (string, error) {
+	policyToSet, err := SecondJSONUnlessEquivalent(exist, new)
+	if err != nil {
+		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
+	}
+
+	canonicalPolicy, err := iampolicy.Canonicalize(policyToSet)
+	if err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
+	}
+
+	return canonicalPolicy, nil
+}
+
+// CanonicalPolicyStateFunc is a schema.SchemaStateFunc that puts an IAM policy in canonical form.
+// Invalid policies are returned as is.
+func CanonicalPolicyStateFunc(v interface{}) string {
+	policy, err := iampolicy.Canonicalize(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+
+	return policy
+}
+
 // LegacyPolicyNormalize returns a "normalized" JSON policy document except
 // the Version element is first in the JSON as required by AWS in many places.
 // Version not being first is one reason for this error:
@@ -223,3 +252,18 @@ func SuppressEquivalentJSONRemovingFieldsDiffs(fields ...string) schema.SchemaDi
 		return JSONStringsEqual(old, new)
 	}
 }
+
+// SuppressEquivalentJSONRemovingPathsDiffs returns a difference suppression function that compares
+// two JSON strings and returns `true` if they are equivalent once the values at the specified paths have been removed.
+// Paths are JSON pointers in which the reference token "*" matches any object key or array index, e.g. "/Statement/*/Sid".
+func SuppressEquivalentJSONRemovingPathsDiffs(paths ...string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if !json.Valid([]byte(old)) || !json.Valid([]byte(new)) {
+			return old == new
+		}
+
+		old, new = tfjson.RemovePaths(old, paths...), tfjson.RemovePaths(new, paths...)
+
+		return JSONStringsEqual(old, new)
+	}
+}
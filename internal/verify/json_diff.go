@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+const (
+	jsonDiffMaxValueLength = 64
+)
+
+// JSONDiff returns the path-level differences between two JSON documents.
+// Each difference is a JSON pointer (RFC 6901) prefixed by "+" if the value was added, "-" if it was removed
+// or "~" if it was changed, followed by the value(s). Differences are ordered by pointer.
+// Array elements are compared by index, and descendants of added, removed or replaced values are not reported.
+func JSONDiff(old, new string) ([]string, error) {
+	oldValues, err := jsonPointerValues(old)
+	if err != nil {
+		return nil, fmt.Errorf("old value: %w", err)
+	}
+
+	newValues, err := jsonPointerValues(new)
+	if err != nil {
+		return nil, fmt.Errorf("new value: %w", err)
+	}
+
+	pointers := make([]string, 0, len(oldValues)+len(newValues))
+	for pointer := range oldValues {
+		pointers = append(pointers, pointer)
+	}
+	for pointer := range newValues {
+		if _, ok := oldValues[pointer]; !ok {
+			pointers = append(pointers, pointer)
+		}
+	}
+	slices.SortFunc(pointers, compareJSONPointers)
+
+	var diffs []string
+	var skip []string // Pointers whose descendants are not reported.
+
+	for _, pointer := range pointers {
+		if slices.ContainsFunc(skip, func(s string) bool { return strings.HasPrefix(pointer, s+"/") }) {
+			continue
+		}
+
+		oldValue, inOld := oldValues[pointer]
+		newValue, inNew := newValues[pointer]
+
+		switch {
+		case inOld && inNew:
+			if oldValue == newValue || (isJSONContainer(oldValue) && isJSONContainer(newValue) && oldValue[0] == newValue[0]) {
+				continue
+			}
+			diffs = append(diffs, fmt.Sprintf("~ %s: %s -> %s", displayJSONPointer(pointer), truncateJSONValue(oldValue), truncateJSONValue(newValue)))
+		case inOld:
+			diffs = append(diffs, fmt.Sprintf("- %s: %s", displayJSONPointer(pointer), truncateJSONValue(oldValue)))
+		case inNew:
+			diffs = append(diffs, fmt.Sprintf("+ %s: %s", displayJSONPointer(pointer), truncateJSONValue(newValue)))
+		}
+
+		skip = append(skip, pointer)
+	}
+
+	return diffs, nil
+}
+
+// JSONDiffDetail returns a diagnostic detail describing the differences returned by JSONDiff.
+func JSONDiffDetail(diffs []string) string {
+	return fmt.Sprintf("Changes by JSON pointer (+ added, - removed, ~ changed):\n\n%s", strings.Join(diffs, "\n"))
+}
+
+// AppendJSONDiffWarning appends a warning diagnostic describing the path-level differences
+// between the old and new values of the JSON-string attribute k.
+// Nothing is appended if either value is not valid JSON or the values are semantically equal.
+// SDKv2 resources cannot return diagnostics when planning, so this is typically called from Update.
+func AppendJSONDiffWarning(diags diag.Diagnostics, k, old, new string) diag.Diagnostics {
+	diffs, err := JSONDiff(old, new)
+
+	if err != nil || len(diffs) == 0 {
+		return diags
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("JSON value of %q changed", k),
+		Detail:   JSONDiffDetail(diffs),
+	})
+}
+
+// LogJSONDiff returns a CustomizeDiffFunc that logs the path-level differences between the
+// prior state and planned values of the JSON-string attribute k.
+// SDKv2 resources cannot return warning diagnostics when planning, so the differences are logged instead;
+// use AppendJSONDiffWarning to also return them as a warning when the change is applied.
+// Nothing is logged on create, if the planned value is unknown, if either value is not valid JSON
+// or if the values are semantically equal.
+func LogJSONDiff(k string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if d.Id() == "" || !d.HasChange(k) || !d.NewValueKnown(k) {
+			return nil
+		}
+
+		o, n := d.GetChange(k)
+		diffs, err := JSONDiff(o.(string), n.(string))
+
+		if err != nil || len(diffs) == 0 {
+			return nil
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("JSON value of %q changed", k), map[string]any{
+			"json_diff": diffs,
+		})
+
+		return nil
+	}
+}
+
+// jsonPointerValues returns a map of JSON pointer to value for every value in a JSON document.
+// Scalar values are in compact form. Objects and arrays are represented by "{…}" and "[…]",
+// or "{}" and "[]" if empty.
+func jsonPointerValues(s string) (map[string]string, error) {
+	if !json.Valid([]byte(s)) {
+		return nil, errors.New("invalid JSON")
+	}
+
+	values := make(map[string]string)
+	var emptyContainer *string // Pointer of the last opened object or array, if it has no members yet.
+
+	err := tfjson.WalkPointers([]byte(s), func(tokens []string, _, value []byte) bool {
+		pointer := tfjson.Pointer(tokens)
+
+		switch value[0] {
+		case '{':
+			values[pointer] = "{…}"
+			emptyContainer = &pointer
+			return true
+		case '[':
+			values[pointer] = "[…]"
+			emptyContainer = &pointer
+			return true
+		case '}', ']':
+			if emptyContainer != nil && *emptyContainer == pointer {
+				values[pointer] = values[pointer][:1] + string(value)
+			}
+			emptyContainer = nil
+			return true
+		}
+
+		emptyContainer = nil
+
+		var v any
+		if err := json.Unmarshal(value, &v); err == nil {
+			if b, err := json.Marshal(v); err == nil {
+				value = b
+			}
+		}
+		values[pointer] = string(value)
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// compareJSONPointers orders JSON pointers by reference token, comparing numeric reference tokens as numbers.
+func compareJSONPointers(a, b string) int {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] == bs[i] {
+			continue
+		}
+
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr == nil && bErr == nil {
+			return an - bn
+		}
+
+		return strings.Compare(as[i], bs[i])
+	}
+
+	return len(as) - len(bs)
+}
+
+func displayJSONPointer(pointer string) string {
+	if pointer == "" {
+		return "(document)"
+	}
+
+	return pointer
+}
+
+func isJSONContainer(value string) bool {
+	return value[0] == '{' || value[0] == '['
+}
+
+func truncateJSONValue(value string) string {
+	if runes := []rune(value); len(runes) > jsonDiffMaxValueLength {
+		return string(runes[:jsonDiffMaxValueLength]) + "…"
+	}
+
+	return value
+}
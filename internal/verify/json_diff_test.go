@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestJSONDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old     string
+		new     string
+		want    []string
+		wantErr bool
+	}{
+		"equal": {
+			old: `{"a": 1, "b": [true, null]}`,
+			new: `{"b":[true,null],"a":1.0}`,
+		},
+		"scalar changes": {
+			old: `{"name": "web", "cpu": 256, "essential": true}`,
+			new: `{"name": "api", "cpu": 512, "essential": true}`,
+			want: []string{
+				`~ /cpu: 256 -> 512`,
+				`~ /name: "web" -> "api"`,
+			},
+		},
+		"added and removed": {
+			old: `{"environment": [{"name": "A", "value": "1"}], "links": ["db"]}`,
+			new: `{"environment": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}], "memory": 512}`,
+			want: []string{
+				`+ /environment/1: {…}`,
+				`- /links: […]`,
+				`+ /memory: 512`,
+			},
+		},
+		"array index order": {
+			old: `[0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11]`,
+			new: `[0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 100, 110]`,
+			want: []string{
+				`~ /10: 10 -> 100`,
+				`~ /11: 11 -> 110`,
+			},
+		},
+		"type change": {
+			old: `{"Resource": ["a", "b"]}`,
+			new: `{"Resource": "a"}`,
+			want: []string{
+				`~ /Resource: […] -> "a"`,
+			},
+		},
+		"empty containers": {
+			old: `{"a": {}, "b": []}`,
+			new: `{"a": {"c": 1}, "b": {}}`,
+			want: []string{
+				`+ /a/c: 1`,
+				`~ /b: [] -> {}`,
+			},
+		},
+		"escaped keys": {
+			old: `{"a/b": {"c~d": 1}}`,
+			new: `{"a/b": {"c~d": 2}}`,
+			want: []string{
+				`~ /a~1b/c~0d: 1 -> 2`,
+			},
+		},
+		"document replaced": {
+			old: `{"a": 1}`,
+			new: `[1]`,
+			want: []string{
+				`~ (document): {…} -> […]`,
+			},
+		},
+		"long value": {
+			old: `{"a": "short"}`,
+			new: `{"a": "0123456789012345678901234567890123456789012345678901234567890123456789"}`,
+			want: []string{
+				`~ /a: "short" -> "012345678901234567890123456789012345678901234567890123456789012…`,
+			},
+		},
+		"invalid": {
+			old:     `{"a": 1}`,
+			new:     `{"a": }`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := JSONDiff(testCase.old, testCase.new)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("JSONDiff() err %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAppendJSONDiffWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old  string
+		new  string
+		want diag.Diagnostics
+	}{
+		"equivalent": {
+			old: `{"a": 1, "b": 2}`,
+			new: `{"b": 2, "a": 1}`,
+		},
+		"changed": {
+			old: `{"a": 1, "b": [1, 2]}`,
+			new: `{"a": 2, "b": [1]}`,
+			want: diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  `JSON value of "definition" changed`,
+					Detail:   "Changes by JSON pointer (+ added, - removed, ~ changed):\n\n~ /a: 1 -> 2\n- /b/1: 2",
+				},
+			},
+		},
+		"invalid": {
+			old: `{"a": 1}`,
+			new: `{"a": }`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := AppendJSONDiffWarning(nil, "definition", testCase.old, testCase.new)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLogJSONDiff(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		CustomizeDiff: LogJSONDiff("definition"),
+	}
+
+	testCases := map[string]struct {
+		id   string
+		old  string
+		new  string
+		want []any
+	}{
+		"create": {
+			new: `{"a": 1}`,
+		},
+		"equivalent": {
+			id:  "id",
+			old: `{"a": 1, "b": 2}`,
+			new: `{"b": 2, "a": 1}`,
+		},
+		"changed": {
+			id:   "id",
+			old:  `{"a": 1, "b": [1, 2]}`,
+			new:  `{"a": 2, "b": [1]}`,
+			want: []any{"~ /a: 1 -> 2", "- /b/1: 2"},
+		},
+		"invalid": {
+			id:  "id",
+			old: `{"a": 1}`,
+			new: `{"a": }`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			state := &terraform.InstanceState{ID: testCase.id}
+			if testCase.id != "" {
+				state.Attributes = map[string]string{"definition": testCase.old}
+			}
+			config := terraform.NewResourceConfigRaw(map[string]any{"definition": testCase.new})
+
+			if _, err := resource.Diff(ctx, state, config, nil); err != nil {
+				t.Fatalf("Diff() err = %s", err)
+			}
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			if err != nil {
+				t.Fatalf("decoding log entries: %s", err)
+			}
+
+			var got []any
+			for _, entry := range entries {
+				if v, ok := entry["json_diff"]; ok {
+					got = v.([]any)
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
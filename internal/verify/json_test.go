@@ -799,3 +799,107 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalPolicyToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		oldPolicy string
+		newPolicy string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "no existing policy",
+			newPolicy: `{"Statement":[{"Resource":"*","Action":["s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			want:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:      "equivalent uses existing",
+			oldPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			newPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:      "not equivalent uses new",
+			oldPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			newPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			want:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name:      "empty",
+			oldPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			newPolicy: "",
+			want:      "",
+		},
+		{
+			name:      "invalid",
+			oldPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			newPolicy: `{"Version":`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CanonicalPolicyToSet(tc.oldPolicy, tc.newPolicy)
+
+			if got, want := err != nil, tc.wantErr; got != want {
+				t.Fatalf("CanonicalPolicyToSet() err %t, want %t", got, want)
+			}
+
+			if got != tc.want {
+				t.Errorf("CanonicalPolicyToSet() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentJSONRemovingPathsDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		paths    []string
+		expected bool
+	}{
+		{
+			name:     "removed path differs",
+			old:      `{"Statement": [{"Sid": "server-generated", "Effect": "Allow"}]}`,
+			new:      `{"Statement": [{"Effect": "Allow"}]}`,
+			paths:    []string{"/Statement/*/Sid"},
+			expected: true,
+		},
+		{
+			name:     "same-named key elsewhere differs",
+			old:      `{"Sid": "a", "Statement": [{"Sid": "x", "Effect": "Allow"}]}`,
+			new:      `{"Sid": "b", "Statement": [{"Effect": "Allow"}]}`,
+			paths:    []string{"/Statement/*/Sid"},
+			expected: false,
+		},
+		{
+			name:     "invalid JSON",
+			old:      `{"a": 1}`,
+			new:      `{"a": `,
+			paths:    []string{"/a"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := SuppressEquivalentJSONRemovingPathsDiffs(tc.paths...)("test", tc.old, tc.new, nil); got != tc.expected {
+				t.Errorf("SuppressEquivalentJSONRemovingPathsDiffs() = %t, want %t", got, tc.expected)
+			}
+		})
+	}
+}
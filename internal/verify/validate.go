@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
@@ -203,6 +204,11 @@ func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy: %s", k, errStr))
 	} else if err := basevalidation.JSONNoDuplicateKeys(value); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains duplicate JSON keys: %s", k, err))
+	} else if warnings, err := iampolicy.Lint(value); err == nil {
+		// Documents that don't follow the IAM policy grammar are left to the service to reject.
+		for _, warning := range warnings {
+			ws = append(ws, fmt.Sprintf("%q contains a possible IAM policy mistake: %s", k, warning))
+		}
 	}
 
 	return //nolint:nakedret // Just a long function.
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -441,6 +442,46 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 	}
 }
 
+func TestValidIAMPolicyJSONWarnings(t *testing.T) {
+	t.Parallel()
+
+	type testCases struct {
+		Value        string
+		WantWarnings []string
+	}
+	tests := []testCases{
+		{
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		{
+			Value: `{"Statement":"not a statement"}`,
+		},
+		{
+			Value: `{"Version":"2012-10-17","Statement":[{"Sid":"Typo","Effect":"Allow","Action":"s4:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:username":"bob"}}}]}`,
+			WantWarnings: []string{
+				`"json" contains a possible IAM policy mistake: statement 0 (Typo): action (s4:GetObject) has unknown service prefix (s4)`,
+				`"json" contains a possible IAM policy mistake: statement 0 (Typo): unknown condition operator (StringEqual)`,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.Value, func(t *testing.T) {
+			t.Parallel()
+
+			ws, errs := ValidIAMPolicyJSON(test.Value, "json")
+
+			for _, err := range errs {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+
+			if diff := cmp.Diff(ws, test.WantWarnings); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestValidStringIsJSONOrYAML(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	humanFriendly     string
+	brand             string
+	sdkID             string
+	endpointAPICall   string
+	awsCLIV2Command   string
+	goV2Package       string
+	providerNameUpper string
+	skipGenerate      bool
+)
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service package",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(service.Options{
+			Name:              name,
+			HumanFriendly:     humanFriendly,
+			Brand:             brand,
+			SDKID:             sdkID,
+			EndpointAPICall:   endpointAPICall,
+			AWSCLIV2Command:   awsCLIV2Command,
+			GoV2Package:       goV2Package,
+			ProviderNameUpper: providerNameUpper,
+			Comments:          !clearComments,
+			Force:             force,
+			Tags:              includeTags,
+			SkipGenerate:      skipGenerate,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the service package (e.g., bedrockagent)")
+	serviceCmd.Flags().StringVarP(&humanFriendly, "human-friendly", "H", "", "human-friendly name of the service as used by AWS (e.g., Agents for Amazon Bedrock)")
+	serviceCmd.Flags().StringVarP(&brand, "brand", "b", "", "brand of the service as used by AWS (AWS or Amazon)")
+	serviceCmd.Flags().StringVarP(&sdkID, "sdk-id", "i", "", "service SDK ID from AWS SDK for Go v2 (e.g., Bedrock Agent)")
+	serviceCmd.Flags().StringVarP(&endpointAPICall, "endpoint-api-call", "e", "", "AWS API operation to use in endpoint tests, taking no required parameters (e.g., ListAgents)")
+	serviceCmd.Flags().StringVar(&awsCLIV2Command, "aws-cli-command", "", "service command in AWS CLI v2, if different from the name (e.g., bedrock-agent)")
+	serviceCmd.Flags().StringVar(&goV2Package, "go-v2-package", "", "AWS SDK for Go v2 package name, if different from the name")
+	serviceCmd.Flags().StringVarP(&providerNameUpper, "provider-name-upper", "u", "", "correctly capitalized name, if different from the SDK ID without spaces (e.g., BedrockAgent)")
+	serviceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	serviceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this service supports tagging and the code for tagging should be generated")
+	serviceCmd.Flags().BoolVar(&skipGenerate, "skip-generate", false, "do not fetch the AWS SDK for Go v2 package or run generators")
+}
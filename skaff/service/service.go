@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+//go:embed readme.tmpl
+var readmeTmpl string
+
+const (
+	namesDataPath       = "names/data/names_data.csv"
+	sdkV2ServicePrefix  = "github.com/aws/aws-sdk-go-v2/service/"
+	defaultTagsGenFlags = "-AWSSDKVersion=2 -KVTValues -ListTags -ServiceTagsMap -SkipTypesImp -UpdateTags"
+)
+
+type TemplateData struct {
+	ServicePackage       string
+	HumanFriendlyService string
+	GoV2Package          string
+	IncludeComments      bool
+	IncludeTags          bool
+	TagsGeneratorFlags   string
+}
+
+// Options are the options for creating a service package.
+type Options struct {
+	Name              string // Provider service package name, e.g. bedrockagent.
+	HumanFriendly     string // e.g. Agents for Amazon Bedrock.
+	Brand             string // AWS, Amazon or blank.
+	SDKID             string // AWS SDK for Go v2 service SDK ID, e.g. Bedrock Agent.
+	EndpointAPICall   string // AWS API operation used in endpoint tests, e.g. ListAgents.
+	AWSCLIV2Command   string // Defaults to Name.
+	GoV2Package       string // Defaults to Name.
+	ProviderNameUpper string // Defaults to SDKID without spaces and dashes, e.g. BedrockAgent.
+	Comments          bool
+	Force             bool
+	Tags              bool
+	SkipGenerate      bool
+}
+
+func Create(opts Options) error {
+	if opts.Name == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if !regexache.MustCompile(`^[a-z][0-9a-z]*$`).MatchString(opts.Name) {
+		return fmt.Errorf("error checking: name should be all lower case letters and digits (e.g., bedrockagent)")
+	}
+
+	if opts.AWSCLIV2Command == "" {
+		opts.AWSCLIV2Command = opts.Name
+	}
+	if opts.GoV2Package == "" {
+		opts.GoV2Package = opts.Name
+	}
+	if opts.ProviderNameUpper == "" {
+		opts.ProviderNameUpper = strings.NewReplacer(" ", "", "-", "").Replace(opts.SDKID)
+	}
+
+	if opts.ProviderNameUpper != "" && strings.ToLower(opts.ProviderNameUpper) != opts.Name {
+		return fmt.Errorf("error checking: provider name upper (%s) should be the properly capitalized name (%s)", opts.ProviderNameUpper, opts.Name)
+	}
+
+	root, err := repositoryRoot()
+	if err != nil {
+		return err
+	}
+
+	namesData := filepath.Join(root, namesDataPath)
+	header, records, err := readServiceData(namesData)
+	if err != nil {
+		return fmt.Errorf("reading service data: %w", err)
+	}
+
+	records, record, err := addServiceRecord(header, records, opts)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(root, "internal", "service", opts.Name)
+	templates := []struct {
+		name     string
+		filename string
+		tmpl     string
+	}{
+		{"generate", filepath.Join(dir, "generate.go"), generateTmpl},
+		{"sweep", filepath.Join(dir, "sweep.go"), sweepTmpl},
+		{"readme", filepath.Join(dir, "README.md"), readmeTmpl},
+	}
+
+	// Check for existing files before making any changes, so that a failure doesn't leave a partially added service.
+	if !opts.Force {
+		for _, t := range templates {
+			if _, err := os.Stat(t.filename); !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("file (%s) already exists and force is not set", t.filename)
+			}
+		}
+	}
+
+	sdkPackage := sdkV2ServicePrefix + opts.GoV2Package
+
+	if !opts.SkipGenerate {
+		if _, err := goOutput(root, "list", "-m", sdkPackage); err != nil {
+			if err := runGo(root, "get", sdkPackage); err != nil {
+				return err
+			}
+		}
+	}
+
+	templateData := TemplateData{
+		ServicePackage:       opts.Name,
+		HumanFriendlyService: columnValue(header, record, "HumanFriendly"),
+		GoV2Package:          opts.GoV2Package,
+		IncludeComments:      opts.Comments,
+		IncludeTags:          opts.Tags,
+	}
+
+	if opts.Tags {
+		templateData.TagsGeneratorFlags = defaultTagsGenFlags
+
+		if !opts.SkipGenerate {
+			dir, err := goOutput(root, "list", "-f", "{{ .Dir }}", sdkPackage)
+			if err != nil {
+				return err
+			}
+
+			flags, err := TagsGeneratorFlags(dir)
+			if err != nil {
+				fmt.Printf("Using default tags generator flags, check generate.go: %s\n", err)
+			} else {
+				templateData.TagsGeneratorFlags = flags
+			}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating service package directory (%s): %w", dir, err)
+	}
+
+	for _, t := range templates {
+		if err := writeTemplate(t.name, t.filename, t.tmpl, opts.Force, templateData); err != nil {
+			return fmt.Errorf("writing %s template: %w", t.name, err)
+		}
+	}
+
+	// The service data is written last as it registers the service with the generators.
+	if err := writeServiceData(namesData, header, records); err != nil {
+		return fmt.Errorf("writing service data: %w", err)
+	}
+
+	fmt.Printf("Added service %s to %s\n", opts.Name, namesDataPath)
+
+	if opts.SkipGenerate {
+		fmt.Printf("Run 'go get %s' and 'make gen' to generate the service client, tagging code, sweeper registration and endpoint tests\n", sdkPackage)
+		return nil
+	}
+
+	// Generators depending on the service data, in dependency order. Service package lists are generated last.
+	for _, pkg := range []string{
+		"./names",
+		"./internal/conns",
+		"./internal/service/" + opts.Name,
+		"./internal/generate/...",
+		"./internal/provider",
+		"./internal/sweep",
+	} {
+		if err := runGo(root, "generate", pkg); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Run 'go mod tidy' and 'go test ./internal/service/%s/...' to check the new service package\n", opts.Name)
+
+	return nil
+}
+
+// repositoryRoot returns the root directory of the provider repository containing the working directory.
+func repositoryRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, namesDataPath)); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("error finding repository root: %s not found, run skaff service in the provider repository", namesDataPath)
+		}
+		dir = parent
+	}
+}
+
+func readServiceData(filename string) ([]string, [][]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil, errors.New("no header")
+	}
+
+	return records[0], records[1:], nil
+}
+
+func writeServiceData(filename string, header []string, records [][]string) error {
+	var buffer bytes.Buffer
+
+	w := csv.NewWriter(&buffer)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buffer.Bytes(), 0644)
+}
+
+func columnIndex(header []string, column string) int {
+	return slices.Index(header, column)
+}
+
+func columnValue(header, record []string, column string) string {
+	if i := columnIndex(header, column); i >= 0 && i < len(record) {
+		return record[i]
+	}
+
+	return ""
+}
+
+func setColumnValue(header, record []string, column, value string) {
+	if i := columnIndex(header, column); i >= 0 && i < len(record) {
+		record[i] = value
+	}
+}
+
+func providerPackage(header, record []string) string {
+	if v := columnValue(header, record, "ProviderPackageActual"); v != "" {
+		return v
+	}
+
+	return columnValue(header, record, "ProviderPackageCorrect")
+}
+
+// addServiceRecord adds a record for a new service to the service data, ordered by AWS CLI v2 command.
+// A record for a service that is not yet implemented is updated instead.
+// The updated service data and the service's record are returned.
+func addServiceRecord(header []string, records [][]string, opts Options) ([][]string, []string, error) {
+	for _, column := range []string{"AWSCLIV2Command", "AWSCLIV2CommandNoDashes", "GoV2Package", "ProviderPackageActual", "ProviderPackageCorrect", "ProviderNameUpper", "ClientSDKV2", "ResourcePrefixActual", "ResourcePrefixCorrect", "DocPrefix", "HumanFriendly", "Brand", "Exclude", "NotImplemented", "EndpointOnly", "SdkId", "EndpointAPICall"} {
+		if columnIndex(header, column) < 0 {
+			return nil, nil, fmt.Errorf("service data column %s not found", column)
+		}
+	}
+
+	switch opts.Brand {
+	case "AWS", "Amazon", "":
+	default:
+		return nil, nil, fmt.Errorf("error checking: brand must be AWS, Amazon, or blank; found %s", opts.Brand)
+	}
+
+	if i := slices.IndexFunc(records, func(r []string) bool { return providerPackage(header, r) == opts.Name }); i >= 0 {
+		record := records[i]
+
+		if columnValue(header, record, "NotImplemented") == "" || columnValue(header, record, "Exclude") != "" {
+			return nil, nil, fmt.Errorf("error checking: service %s already exists in service data", opts.Name)
+		}
+
+		for column, value := range map[string]string{
+			"HumanFriendly":     opts.HumanFriendly,
+			"Brand":             opts.Brand,
+			"SdkId":             opts.SDKID,
+			"EndpointAPICall":   opts.EndpointAPICall,
+			"ProviderNameUpper": opts.ProviderNameUpper,
+		} {
+			if value != "" {
+				setColumnValue(header, record, column, value)
+			}
+		}
+
+		if columnValue(header, record, "GoV2Package") == "" {
+			setColumnValue(header, record, "GoV2Package", opts.GoV2Package)
+		}
+		if columnValue(header, record, "DocPrefix") == "" {
+			setColumnValue(header, record, "DocPrefix", opts.Name+"_")
+		}
+		// New service packages use AWS SDK for Go v2 only.
+		setColumnValue(header, record, "ClientSDKV1", "")
+		setColumnValue(header, record, "ClientSDKV2", "2")
+		setColumnValue(header, record, "NotImplemented", "")
+		setColumnValue(header, record, "EndpointOnly", "")
+
+		if err := checkServiceRecord(header, record); err != nil {
+			return nil, nil, err
+		}
+
+		return records, record, nil
+	}
+
+	noDashes := strings.ReplaceAll(opts.AWSCLIV2Command, "-", "")
+	correct := opts.GoV2Package
+	if len(noDashes) < len(correct) {
+		correct = noDashes
+	}
+
+	record := make([]string, len(header))
+	setColumnValue(header, record, "AWSCLIV2Command", opts.AWSCLIV2Command)
+	setColumnValue(header, record, "AWSCLIV2CommandNoDashes", noDashes)
+	setColumnValue(header, record, "GoV2Package", opts.GoV2Package)
+	setColumnValue(header, record, "ProviderPackageCorrect", correct)
+	setColumnValue(header, record, "ProviderNameUpper", opts.ProviderNameUpper)
+	setColumnValue(header, record, "ClientSDKV2", "2")
+	setColumnValue(header, record, "ResourcePrefixCorrect", fmt.Sprintf("aws_%s_", correct))
+	setColumnValue(header, record, "DocPrefix", opts.Name+"_")
+	setColumnValue(header, record, "HumanFriendly", opts.HumanFriendly)
+	setColumnValue(header, record, "Brand", opts.Brand)
+	setColumnValue(header, record, "SdkId", opts.SDKID)
+	setColumnValue(header, record, "EndpointAPICall", opts.EndpointAPICall)
+
+	if opts.Name != correct {
+		setColumnValue(header, record, "ProviderPackageActual", opts.Name)
+		setColumnValue(header, record, "ResourcePrefixActual", fmt.Sprintf("aws_%s_", opts.Name))
+	}
+
+	if err := checkServiceRecord(header, record); err != nil {
+		return nil, nil, err
+	}
+
+	return slices.Insert(records, insertionIndex(header, records, opts.AWSCLIV2Command), record), record, nil
+}
+
+// insertionIndex returns the index at which to insert a record for the specified AWS CLI v2 command.
+// The service data is mostly, but not strictly, ordered by AWS CLI v2 command, so the record is inserted
+// where the fewest other records are out of order relative to it.
+func insertionIndex(header []string, records [][]string, command string) int {
+	index, least := len(records), len(records)+1
+
+	for i := 0; i <= len(records); i++ {
+		n := 0
+		for j, r := range records {
+			v := columnValue(header, r, "AWSCLIV2Command")
+			if v == "" {
+				continue
+			}
+			if (j < i && v > command) || (j >= i && v < command) {
+				n++
+			}
+		}
+
+		if n < least {
+			index, least = i, n
+		}
+	}
+
+	return index
+}
+
+// checkServiceRecord checks that the columns required for an implemented service have values.
+func checkServiceRecord(header, record []string) error {
+	for _, v := range []struct {
+		column, flag string
+	}{
+		{"HumanFriendly", "human-friendly"},
+		{"SdkId", "sdk-id"},
+		{"ProviderNameUpper", "provider-name-upper"},
+		{"EndpointAPICall", "endpoint-api-call"},
+	} {
+		if columnValue(header, record, v.column) == "" {
+			return fmt.Errorf("error checking: %s is required, use --%s", v.column, v.flag)
+		}
+	}
+
+	return nil
+}
+
+// TagsGeneratorFlags returns the tags generator flags for the AWS SDK for Go v2 service package in the specified directory.
+// The service's tagging operations must be TagResource, UntagResource and (optionally) ListTagsForResource.
+func TagsGeneratorFlags(dir string) (string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return strings.HasPrefix(fi.Name(), "api_op_") && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return "", err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				if spec, ok := n.(*ast.TypeSpec); ok {
+					if s, ok := spec.Type.(*ast.StructType); ok {
+						structs[spec.Name.Name] = s
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	tagIn, ok := structs["TagResourceInput"]
+	if !ok {
+		return "", errors.New("TagResource operation not found")
+	}
+	untagIn, ok := structs["UntagResourceInput"]
+	if !ok {
+		return "", errors.New("UntagResource operation not found")
+	}
+
+	tagsField, tagsType := tagsElem(tagIn)
+	if tagsField == "" {
+		return "", errors.New("TagResource tags not found")
+	}
+
+	flags := []string{"-UpdateTags"}
+	switch tagsType {
+	case "map":
+		flags = append(flags, "-KVTValues", "-ServiceTagsMap", "-SkipTypesImp")
+	case "slice":
+		flags = append(flags, "-ServiceTagsSlice")
+	}
+
+	if v := stringElem(tagIn); v != "" && v != "ResourceArn" {
+		flags = append(flags, "-TagInIDElem="+v)
+	}
+	if tagsField != "Tags" {
+		flags = append(flags, "-TagInTagsElem="+tagsField)
+	}
+	if v := stringSliceElem(untagIn); v != "" && v != "TagKeys" {
+		flags = append(flags, "-UntagInTagsElem="+v)
+	}
+
+	if listTagsIn, ok := structs["ListTagsForResourceInput"]; ok {
+		flags = append(flags, "-ListTags")
+
+		if v := stringElem(listTagsIn); v != "" && v != "ResourceArn" {
+			flags = append(flags, "-ListTagsInIDElem="+v)
+		}
+		if listTagsOut, ok := structs["ListTagsForResourceOutput"]; ok {
+			if v, _ := tagsElem(listTagsOut); v != "" && v != "Tags" {
+				flags = append(flags, "-ListTagsOutTagsElem="+v)
+			}
+		}
+	}
+
+	slices.Sort(flags)
+
+	return strings.Join(append([]string{"-AWSSDKVersion=2"}, flags...), " "), nil
+}
+
+// tagsElem returns the name of a struct's tags field and whether the tags are a "map" or "slice".
+func tagsElem(s *ast.StructType) (string, string) {
+	for _, field := range s.Fields.List {
+		for _, name := range field.Names {
+			switch t := field.Type.(type) {
+			case *ast.MapType:
+				return name.Name, "map"
+			case *ast.ArrayType:
+				if sel, ok := t.Elt.(*ast.SelectorExpr); ok && sel.Sel.Name == "Tag" {
+					return name.Name, "slice"
+				}
+			}
+		}
+	}
+
+	return "", ""
+}
+
+// stringElem returns the name of a struct's first *string field, its resource identifier.
+func stringElem(s *ast.StructType) string {
+	for _, field := range s.Fields.List {
+		if star, ok := field.Type.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "string" && len(field.Names) > 0 {
+				return field.Names[0].Name
+			}
+		}
+	}
+
+	return ""
+}
+
+// stringSliceElem returns the name of a struct's first []string field, its tag keys.
+func stringSliceElem(s *ast.StructType) string {
+	for _, field := range s.Fields.List {
+		if array, ok := field.Type.(*ast.ArrayType); ok {
+			if ident, ok := array.Elt.(*ast.Ident); ok && ident.Name == "string" && len(field.Names) > 0 {
+				return field.Names[0].Name
+			}
+		}
+	}
+
+	return ""
+}
+
+func runGo(dir string, args ...string) error {
+	fmt.Printf("Running go %s\n", strings.Join(args, " "))
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running go %s: %w", strings.Join(args, " "), err)
+	}
+
+	return nil
+}
+
+func goOutput(dir string, args ...string) (string, error) {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running go %s: %w", strings.Join(args, " "), err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testHeader = []string{"AWSCLIV2Command", "AWSCLIV2CommandNoDashes", "GoV1Package", "GoV2Package", "ProviderPackageActual", "ProviderPackageCorrect", "ProviderNameUpper", "ClientSDKV1", "ClientSDKV2", "ResourcePrefixActual", "ResourcePrefixCorrect", "DocPrefix", "HumanFriendly", "Brand", "Exclude", "NotImplemented", "EndpointOnly", "SdkId", "EndpointAPICall", "Note"}
+
+func testRecords() [][]string {
+	return [][]string{
+		strings.Split("accessanalyzer,accessanalyzer,accessanalyzer,accessanalyzer,,accessanalyzer,AccessAnalyzer,,2,,aws_accessanalyzer_,accessanalyzer_,IAM Access Analyzer,AWS,,,,AccessAnalyzer,ListAnalyzers,", ","),
+		strings.Split("braket,braket,braket,braket,,braket,Braket,1,,,aws_braket_,braket_,Braket,Amazon,,x,,Braket,,", ","),
+		strings.Split("mgn,mgn,mgn,mgn,,mgn,Mgn,1,,,aws_mgn_,mgn_,Application Migration,AWS,,x,,mgn,,", ","),
+		strings.Split("ecs,ecs,ecs,ecs,,ecs,ECS,,2,,aws_ecs_,ecs_,ECS (Elastic Container),Amazon,,,,ECS,ListClusters,", ","),
+		strings.Split("xray,xray,xray,xray,,xray,XRay,,2,,aws_xray_,xray_,X-Ray,AWS,,,,XRay,ListResourcePolicies,", ","),
+	}
+}
+
+func TestAddServiceRecord(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Options       Options
+		ExpectedIndex int
+		Expected      string
+		ExpectedError string
+	}{
+		{
+			TestName: "new",
+			Options: Options{
+				Name:              "bedrockagent",
+				HumanFriendly:     "Agents for Amazon Bedrock",
+				Brand:             "Amazon",
+				SDKID:             "Bedrock Agent",
+				EndpointAPICall:   "ListAgents",
+				AWSCLIV2Command:   "bedrock-agent",
+				GoV2Package:       "bedrockagent",
+				ProviderNameUpper: "BedrockAgent",
+			},
+			ExpectedIndex: 1,
+			Expected:      "bedrock-agent,bedrockagent,,bedrockagent,,bedrockagent,BedrockAgent,,2,,aws_bedrockagent_,bedrockagent_,Agents for Amazon Bedrock,Amazon,,,,Bedrock Agent,ListAgents,",
+		},
+		{
+			TestName: "new actual package",
+			Options: Options{
+				Name:              "fsxontap",
+				HumanFriendly:     "FSx for NetApp ONTAP",
+				Brand:             "Amazon",
+				SDKID:             "FSx",
+				EndpointAPICall:   "DescribeFileSystems",
+				AWSCLIV2Command:   "fsx",
+				GoV2Package:       "fsx",
+				ProviderNameUpper: "FSxONTAP",
+			},
+			ExpectedIndex: 2,
+			Expected:      "fsx,fsx,,fsx,fsxontap,fsx,FSxONTAP,,2,aws_fsxontap_,aws_fsx_,fsxontap_,FSx for NetApp ONTAP,Amazon,,,,FSx,DescribeFileSystems,",
+		},
+		{
+			TestName: "not implemented",
+			Options: Options{
+				Name:              "braket",
+				SDKID:             "Braket",
+				EndpointAPICall:   "SearchDevices",
+				AWSCLIV2Command:   "braket",
+				GoV2Package:       "braket",
+				ProviderNameUpper: "Braket",
+			},
+			ExpectedIndex: 1,
+			Expected:      "braket,braket,braket,braket,,braket,Braket,,2,,aws_braket_,braket_,Braket,Amazon,,,,Braket,SearchDevices,",
+		},
+		{
+			TestName: "not implemented missing endpoint API call",
+			Options: Options{
+				Name:              "braket",
+				AWSCLIV2Command:   "braket",
+				GoV2Package:       "braket",
+				ProviderNameUpper: "Braket",
+			},
+			ExpectedError: "EndpointAPICall is required",
+		},
+		{
+			TestName: "implemented",
+			Options: Options{
+				Name:            "ecs",
+				AWSCLIV2Command: "ecs",
+				GoV2Package:     "ecs",
+			},
+			ExpectedError: "already exists",
+		},
+		{
+			TestName: "missing human-friendly name",
+			Options: Options{
+				Name:              "bedrockagent",
+				SDKID:             "Bedrock Agent",
+				EndpointAPICall:   "ListAgents",
+				AWSCLIV2Command:   "bedrock-agent",
+				GoV2Package:       "bedrockagent",
+				ProviderNameUpper: "BedrockAgent",
+			},
+			ExpectedError: "HumanFriendly is required",
+		},
+		{
+			TestName: "invalid brand",
+			Options: Options{
+				Name:  "bedrockagent",
+				Brand: "Acme",
+			},
+			ExpectedError: "brand must be AWS, Amazon, or blank",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			records, record, err := addServiceRecord(testHeader, testRecords(), testCase.Options)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("got error %v, expected %s", err, testCase.ExpectedError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := strings.Join(record, ","); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got := strings.Join(records[testCase.ExpectedIndex], ","); got != testCase.Expected {
+				t.Errorf("got record %d %s, expected %s", testCase.ExpectedIndex, got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTagsGeneratorFlags(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Files         map[string]string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			TestName: "map",
+			Files: map[string]string{
+				"api_op_TagResource.go":         "package x\n\ntype TagResourceInput struct {\n\tResourceArn *string\n\tTags map[string]string\n}\n",
+				"api_op_UntagResource.go":       "package x\n\ntype UntagResourceInput struct {\n\tResourceArn *string\n\tTagKeys []string\n}\n",
+				"api_op_ListTagsForResource.go": "package x\n\ntype ListTagsForResourceInput struct {\n\tResourceArn *string\n}\n\ntype ListTagsForResourceOutput struct {\n\tTags map[string]string\n}\n",
+			},
+			Expected: "-AWSSDKVersion=2 -KVTValues -ListTags -ServiceTagsMap -SkipTypesImp -UpdateTags",
+		},
+		{
+			TestName: "slice",
+			Files: map[string]string{
+				"api_op_TagResource.go":         "package x\n\ntype TagResourceInput struct {\n\tResourceARN *string\n\tTags []types.Tag\n}\n",
+				"api_op_UntagResource.go":       "package x\n\ntype UntagResourceInput struct {\n\tResourceARN *string\n\tTagKeys []string\n}\n",
+				"api_op_ListTagsForResource.go": "package x\n\ntype ListTagsForResourceInput struct {\n\tResourceARN *string\n}\n\ntype ListTagsForResourceOutput struct {\n\tTagList []types.Tag\n}\n",
+			},
+			Expected: "-AWSSDKVersion=2 -ListTags -ListTagsInIDElem=ResourceARN -ListTagsOutTagsElem=TagList -ServiceTagsSlice -TagInIDElem=ResourceARN -UpdateTags",
+		},
+		{
+			TestName: "no list tags",
+			Files: map[string]string{
+				"api_op_TagResource.go":   "package x\n\ntype TagResourceInput struct {\n\tResourceArn *string\n\tTags map[string]string\n}\n",
+				"api_op_UntagResource.go": "package x\n\ntype UntagResourceInput struct {\n\tResourceArn *string\n\tKeys []string\n}\n",
+			},
+			Expected: "-AWSSDKVersion=2 -KVTValues -ServiceTagsMap -SkipTypesImp -UntagInTagsElem=Keys -UpdateTags",
+		},
+		{
+			TestName: "no tagging",
+			Files: map[string]string{
+				"api_op_ListQueues.go": "package x\n\ntype ListQueuesInput struct {\n\tNextToken *string\n}\n",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range testCase.Files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := TagsGeneratorFlags(dir)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestCreateExistingFile(t *testing.T) {
+	root := t.TempDir()
+	namesData := filepath.Join(root, namesDataPath)
+	dir := filepath.Join(root, "internal", "service", "bedrockagent")
+
+	for _, d := range []string{filepath.Dir(namesData), dir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writeServiceData(namesData, testHeader, testRecords()); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sweep.go"), []byte("package bedrockagent\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile(namesData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(root); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd) //nolint:errcheck
+	})
+
+	err = Create(Options{
+		Name:              "bedrockagent",
+		HumanFriendly:     "Agents for Amazon Bedrock",
+		Brand:             "Amazon",
+		SDKID:             "Bedrock Agent",
+		EndpointAPICall:   "ListAgents",
+		AWSCLIV2Command:   "bedrock-agent",
+		ProviderNameUpper: "BedrockAgent",
+		SkipGenerate:      true,
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("got error %v, expected already exists", err)
+	}
+
+	if got, err := os.ReadFile(namesData); err != nil {
+		t.Fatal(err)
+	} else if string(got) != string(want) {
+		t.Errorf("service data changed:\n%s", got)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "generate.go")); err == nil {
+		t.Errorf("generate.go written")
+	}
+}